@@ -0,0 +1,238 @@
+// Package backtest 预测器的滚动回测：按历史日 K 还原过去每个交易日收盘后的数据快照，
+// 用与线上相同的流水线预测，再以其后的实际收盘价评估方向命中率、置信度校准与按结论操作的收益。
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+const (
+	// warmupBars 预测日（含）之前至少需要的日 K 数（量化模型与蒙特卡洛模拟的下限）
+	warmupBars = quant.MinBars
+	// predictorWarmup 首个预测日前拉取的日 K 数，与预测器计算技术指标所用的数量一致
+	predictorWarmup = 120
+)
+
+// Config 回测参数
+type Config struct {
+	Codes            []string `json:"codes"`
+	From             string   `json:"from"` // 首个预测日 YYYY-MM-DD（含）
+	To               string   `json:"to"`   // 最后一个预测日 YYYY-MM-DD（含）
+	Days             int32    `json:"days"` // 预测周期（交易日），以第 Days 个交易日收盘评估
+	Step             int      `json:"step"` // 每隔 Step 个交易日预测一次
+	Models           []string `json:"models"`
+	TemplateVersions []string `json:"template_versions,omitempty"` // LLM 模型逐一使用的模板版本，为空时按权重选择
+	Mode             string   `json:"mode,omitempty"`
+	NeutralPct       float64  `json:"neutral_pct"` // 实际涨跌幅绝对值不超过此值视为震荡
+	Concurrency      int      `json:"concurrency"`
+	Refresh          bool     `json:"-"` // 重新拉取并覆盖已保存的日 K
+}
+
+func (c *Config) normalize() error {
+	if len(c.Codes) == 0 {
+		return fmt.Errorf("codes 不能为空")
+	}
+	if _, err := time.Parse("2006-01-02", c.From); err != nil {
+		return fmt.Errorf("from 须为 YYYY-MM-DD: %w", err)
+	}
+	if _, err := time.Parse("2006-01-02", c.To); err != nil {
+		return fmt.Errorf("to 须为 YYYY-MM-DD: %w", err)
+	}
+	if c.To < c.From {
+		return fmt.Errorf("to 早于 from")
+	}
+	if err := predictor.CheckDays(c.Days); err != nil {
+		return err
+	}
+	if c.Days <= 0 {
+		c.Days = 3
+	}
+	if c.Step <= 0 {
+		c.Step = 1
+	}
+	if len(c.Models) == 0 {
+		c.Models = []string{""}
+	}
+	if len(c.TemplateVersions) == 0 {
+		c.TemplateVersions = []string{""}
+	}
+	if c.NeutralPct <= 0 {
+		c.NeutralPct = 1
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = 1
+	}
+	return nil
+}
+
+// task 一次历史预测
+type task struct {
+	code, model, version string
+	asOf                 int // 预测日在 bars 中的下标
+	bars                 []*stock.KLine
+}
+
+// Run 执行回测。sc 用于拉取历史日 K（保存于数据目录 backtest/klines，再次回测时复用），
+// progress 在每次预测结束后以 (已完成, 总数) 回调，可为 nil。单次预测失败计入样本的 Error，不中止回测。
+func Run(ctx context.Context, p *predictor.Predictor, sc stockservice.Client, cfg Config, progress func(done, total int)) (*Report, error) {
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	ctx = usage.WithEndpoint(ctx, "backtest")
+	index, err := loadKlines(ctx, sc, quant.IndexSecID, cfg)
+	if err != nil {
+		log.Printf("[Backtest] 恒指日 K 获取失败，不计大盘与相对强弱: %v", err)
+	}
+
+	var tasks []task
+	for _, code := range cfg.Codes {
+		bars, err := loadKlines(ctx, sc, code, cfg)
+		if err != nil {
+			return nil, err
+		}
+		dates := 0
+		for i, b := range bars {
+			if b.Date < cfg.From || b.Date > cfg.To || i+1 < warmupBars || i+int(cfg.Days) >= len(bars) {
+				continue
+			}
+			if dates++; (dates-1)%cfg.Step != 0 {
+				continue
+			}
+			for _, model := range cfg.Models {
+				versions := cfg.TemplateVersions
+				if model == quant.ModelName {
+					versions = []string{""}
+				}
+				for _, v := range versions {
+					tasks = append(tasks, task{code: code, model: model, version: v, asOf: i, bars: bars})
+				}
+			}
+		}
+		if dates == 0 {
+			log.Printf("[Backtest] %s 在 %s ~ %s 内无可评估的交易日（需 %d 根预热 K 线且其后有 %d 个交易日）",
+				code, cfg.From, cfg.To, warmupBars, cfg.Days)
+		}
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("区间内没有可评估的预测日")
+	}
+
+	samples := make([]*Sample, len(tasks))
+	sem := make(chan struct{}, cfg.Concurrency)
+	var mu sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	for i, t := range tasks {
+		wg.Add(1)
+		go func(i int, t task) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				samples[i] = newSample(t, cfg)
+				samples[i].Error = ctx.Err().Error()
+				return
+			}
+			defer func() { <-sem }()
+			samples[i] = predict(ctx, p, t, index, cfg)
+			if progress != nil {
+				mu.Lock()
+				done++
+				progress(done, len(tasks))
+				mu.Unlock()
+			}
+		}(i, t)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return newReport(cfg, samples), nil
+}
+
+// predict 以截至预测日的日 K 预测并按其后第 Days 个交易日的收盘评估
+func predict(ctx context.Context, p *predictor.Predictor, t task, index []*stock.KLine, cfg Config) *Sample {
+	s := newSample(t, cfg)
+	res, err := p.Backtest(ctx, predictor.Request{
+		Code: t.code, Days: cfg.Days, Model: t.model, TemplateVersion: t.version, Mode: cfg.Mode,
+	}, t.bars[:t.asOf+1], until(index, s.AsOf))
+	if err != nil {
+		s.Error = err.Error()
+		return s
+	}
+	s.score(res)
+	return s
+}
+
+// until 截至 date（含）的日 K
+func until(bars []*stock.KLine, date string) []*stock.KLine {
+	n := sort.Search(len(bars), func(i int) bool { return bars[i].Date > date })
+	return bars[:n]
+}
+
+// klineFile 数据目录中保存的历史日 K
+type klineFile struct {
+	Code    string         `json:"code"`
+	Fetched time.Time      `json:"fetched"`
+	Limit   int            `json:"limit"` // 拉取时请求的数量，返回不足说明已是全部历史
+	Klines  []*stock.KLine `json:"klines"`
+}
+
+// loadKlines 读取已保存的日 K；不存在、Refresh 或未覆盖回测区间（含预热与评估所需 K 线）时重新拉取并保存。
+func loadKlines(ctx context.Context, sc stockservice.Client, code string, cfg Config) ([]*stock.KLine, error) {
+	path := storage.Path("backtest", "klines", strings.ReplaceAll(code, "/", "_")+".json")
+	var f klineFile
+	if !cfg.Refresh && storage.ReadJSON(path, &f) == nil && f.covers(cfg) {
+		return f.Klines, nil
+	}
+
+	from, _ := time.Parse("2006-01-02", cfg.From)
+	// 自预热起点至今的日历天数足以覆盖所需交易日数
+	limit := int(time.Since(from).Hours()/24) + 2*predictorWarmup
+	resp, err := sc.GetKline(ctx, &stock.GetKlineRequest{Code: code, Period: "day", Limit: int32(limit)})
+	if err != nil {
+		return nil, fmt.Errorf("获取 %s 日 K 失败: %w", code, err)
+	}
+	if resp == nil || len(resp.Klines) == 0 {
+		return nil, fmt.Errorf("%s 无日 K 数据", code)
+	}
+	f = klineFile{Code: code, Fetched: time.Now(), Limit: limit, Klines: resp.Klines}
+	if err := storage.WriteJSON(path, &f); err != nil {
+		log.Printf("[Backtest] 保存 %s 日 K 失败: %v", code, err)
+	}
+	return f.Klines, nil
+}
+
+// covers 已保存的日 K 是否覆盖回测区间：首个预测日前有足够预热 K 线（或已是上市以来全部 K 线），
+// 且晚于 To 的 K 线足够评估最后一个预测日的 Days 个交易日（最后一根已是今天时暂无更新的数据，不再重新拉取）
+func (f *klineFile) covers(cfg Config) bool {
+	n := len(f.Klines)
+	if n == 0 {
+		return false
+	}
+	before, after := 0, 0
+	for _, b := range f.Klines {
+		if b.Date < cfg.From {
+			before++
+		} else if b.Date > cfg.To {
+			after++
+		}
+	}
+	if after < int(cfg.Days) && f.Klines[n-1].Date < time.Now().Format("2006-01-02") {
+		return false
+	}
+	return before >= predictorWarmup || n < f.Limit
+}
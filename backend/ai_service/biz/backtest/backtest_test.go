@@ -0,0 +1,42 @@
+package backtest
+
+import (
+	"testing"
+	"time"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// bars 从 start 起连续 n 个自然日的日 K
+func bars(start time.Time, n int) []*stock.KLine {
+	out := make([]*stock.KLine, n)
+	for i := range out {
+		out[i] = &stock.KLine{Date: start.AddDate(0, 0, i).Format("2006-01-02"), Close: 1}
+	}
+	return out
+}
+
+func TestKlineFileCovers(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	cfg := Config{From: "2024-06-01", To: "2024-06-30", Days: 5}
+	y, m, d := time.Now().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	recent := Config{From: today.AddDate(0, 0, -10).Format("2006-01-02"), To: today.AddDate(0, 0, -2).Format("2006-01-02"), Days: 5}
+	tests := []struct {
+		name  string
+		cfg   Config
+		file  klineFile
+		cover bool
+	}{
+		{"评估 K 线足够", cfg, klineFile{Limit: 1000, Klines: bars(start, 187)}, true},  // 截至 07-05
+		{"评估 K 线不足", cfg, klineFile{Limit: 1000, Klines: bars(start, 184)}, false}, // 截至 07-02
+		{"预热不足", cfg, klineFile{Limit: 100, Klines: bars(start.AddDate(0, 3, 0), 100)}, false},
+		{"上市以来全部 K 线", cfg, klineFile{Limit: 1000, Klines: bars(start.AddDate(0, 3, 0), 100)}, true},
+		{"最后一根为今天", recent, klineFile{Limit: 1000, Klines: bars(today.AddDate(0, 0, -199), 200)}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.file.covers(tt.cfg); got != tt.cover {
+			t.Errorf("%s: covers = %v, want %v", tt.name, got, tt.cover)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/calibration"
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+)
+
+// Sample 一次历史预测及其实际结果
+type Sample struct {
+	Code            string  `json:"code"`
+	AsOf            string  `json:"as_of"` // 预测日
+	Model           string  `json:"model"`
+	TemplateVersion string  `json:"template_version,omitempty"`
+	Entry           float64 `json:"entry"` // 预测日收盘
+	Exit            float64 `json:"exit"`  // 第 Days 个交易日收盘
+	ExitDate        string  `json:"exit_date"`
+	ActualPct       float64 `json:"actual_pct"`     // 实际涨跌幅（%）
+	Actual          string  `json:"actual"`         // 实际方向
+	Direction       string  `json:"direction"`      // 预测方向，未解析出结论时为空
+	Confidence      float64 `json:"confidence"`     // 校准后
+	RawConfidence   float64 `json:"raw_confidence"` // 模型原值，0 表示未给出
+	PriceLow        float64 `json:"price_low"`
+	PriceHigh       float64 `json:"price_high"`
+	Hit             bool    `json:"hit"`             // 方向是否正确
+	InRange         *bool   `json:"in_range"`        // 实际收盘是否落在预计区间内，无区间时为 nil
+	ReturnPct       float64 `json:"return_pct"`      // 按结论操作的收益（%）：看多做多、看空做空、震荡空仓
+	Error           string  `json:"error,omitempty"` // 预测失败原因
+}
+
+func newSample(t task, cfg Config) *Sample {
+	entry, exit := t.bars[t.asOf], t.bars[t.asOf+int(cfg.Days)]
+	s := &Sample{
+		Code: t.code, AsOf: entry.Date, Model: t.model, TemplateVersion: t.version,
+		Entry: entry.Close, Exit: exit.Close, ExitDate: exit.Date,
+	}
+	if entry.Close > 0 {
+		s.ActualPct = round((exit.Close/entry.Close-1)*100, 3)
+	}
+	s.Actual = calibration.ActualDirection(s.ActualPct, cfg.NeutralPct)
+	return s
+}
+
+// score 按预测结果填充方向、置信度与区间并计算命中与收益
+func (s *Sample) score(res *predictor.Result) {
+	s.Model, s.TemplateVersion, s.Confidence, s.RawConfidence = res.Model, res.TemplateVersion, res.Confidence, res.RawConfidence
+	v := res.Verdict
+	if v == nil {
+		return
+	}
+	s.Direction, s.PriceLow, s.PriceHigh = v.Direction, v.PriceLow, v.PriceHigh
+	s.Hit = s.Direction == s.Actual
+	if v.PriceLow > 0 && v.PriceHigh >= v.PriceLow {
+		in := s.Exit >= v.PriceLow && s.Exit <= v.PriceHigh
+		s.InRange = &in
+	}
+	switch s.Direction {
+	case predictor.DirectionBullish:
+		s.ReturnPct = s.ActualPct
+	case predictor.DirectionBearish:
+		s.ReturnPct = -s.ActualPct
+	}
+}
+
+// Bucket 置信度分桶：预测置信度与实际命中率对照
+type Bucket struct {
+	Low           float64 `json:"low"`
+	High          float64 `json:"high"`
+	Count         int     `json:"count"`
+	AvgConfidence float64 `json:"avg_confidence"`
+	HitRate       float64 `json:"hit_rate"`
+}
+
+// Group 同一模型与模板版本的汇总
+type Group struct {
+	Model           string    `json:"model"`
+	TemplateVersion string    `json:"template_version,omitempty"`
+	Samples         int       `json:"samples"`
+	Failed          int       `json:"failed"`   // 预测失败
+	Unparsed        int       `json:"unparsed"` // 未解析出结论
+	Scored          int       `json:"scored"`   // 参与评估的样本
+	HitRate         float64   `json:"hit_rate"`
+	RangeScored     int       `json:"range_scored"`
+	RangeHitRate    float64   `json:"range_hit_rate"`
+	AvgConfidence   float64   `json:"avg_confidence"`
+	Brier           float64   `json:"brier"` // (置信度 - 是否命中)² 的均值，越小越好
+	AvgActualPct    float64   `json:"avg_actual_pct"`
+	AvgReturnPct    float64   `json:"avg_return_pct"`
+	TotalReturnPct  float64   `json:"total_return_pct"` // 各次收益简单相加
+	WinRate         float64   `json:"win_rate"`         // 有持仓（非震荡）的预测中收益为正的比例
+	Directions      Counts    `json:"directions"`       // 预测方向分布
+	Calibration     []*Bucket `json:"calibration"`
+}
+
+// Counts 方向计数
+type Counts struct {
+	Bullish int `json:"bullish"`
+	Bearish int `json:"bearish"`
+	Neutral int `json:"neutral"`
+}
+
+// Report 回测报告
+type Report struct {
+	ID      string    `json:"id"`
+	Created time.Time `json:"created"`
+	Config  Config    `json:"config"`
+	Groups  []*Group  `json:"groups"`
+	Samples []*Sample `json:"samples"`
+}
+
+// calibrationBuckets 置信度分桶下界，最后一桶含 1
+var calibrationBuckets = []float64{0, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+func newReport(cfg Config, samples []*Sample) *Report {
+	r := &Report{ID: storage.NewID(), Created: time.Now(), Config: cfg, Samples: samples}
+	groups := map[string]*Group{}
+	scored := map[*Group][]*Sample{}
+	for _, s := range samples {
+		key := s.Model + "|" + s.TemplateVersion
+		g, ok := groups[key]
+		if !ok {
+			g = &Group{Model: s.Model, TemplateVersion: s.TemplateVersion}
+			groups[key] = g
+			r.Groups = append(r.Groups, g)
+		}
+		g.Samples++
+		switch {
+		case s.Error != "":
+			g.Failed++
+		case s.Direction == "":
+			g.Unparsed++
+		default:
+			scored[g] = append(scored[g], s)
+		}
+	}
+	for _, g := range r.Groups {
+		g.summarize(scored[g])
+	}
+	sort.SliceStable(r.Groups, func(a, b int) bool {
+		if r.Groups[a].Model != r.Groups[b].Model {
+			return r.Groups[a].Model < r.Groups[b].Model
+		}
+		return r.Groups[a].TemplateVersion < r.Groups[b].TemplateVersion
+	})
+	return r
+}
+
+func (g *Group) summarize(samples []*Sample) {
+	g.Scored = len(samples)
+	g.Calibration = []*Bucket{}
+	if g.Scored == 0 {
+		return
+	}
+	var hits, inRange, positions, wins int
+	var conf, brier, actual, ret float64
+	buckets := make([]*Bucket, len(calibrationBuckets))
+	for i, low := range calibrationBuckets {
+		buckets[i] = &Bucket{Low: low, High: 1}
+		if i+1 < len(calibrationBuckets) {
+			buckets[i].High = calibrationBuckets[i+1]
+		}
+	}
+	for _, s := range samples {
+		hit := 0.0
+		if s.Hit {
+			hits++
+			hit = 1
+		}
+		if s.InRange != nil {
+			g.RangeScored++
+			if *s.InRange {
+				inRange++
+			}
+		}
+		switch s.Direction {
+		case predictor.DirectionBullish:
+			g.Directions.Bullish++
+		case predictor.DirectionBearish:
+			g.Directions.Bearish++
+		default:
+			g.Directions.Neutral++
+		}
+		if s.Direction != predictor.DirectionNeutral {
+			positions++
+			if s.ReturnPct > 0 {
+				wins++
+			}
+		}
+		conf += s.Confidence
+		brier += (s.Confidence - hit) * (s.Confidence - hit)
+		actual += s.ActualPct
+		ret += s.ReturnPct
+		for i := len(buckets) - 1; i >= 0; i-- {
+			if b := buckets[i]; s.Confidence >= b.Low || i == 0 {
+				b.Count++
+				b.AvgConfidence += s.Confidence
+				b.HitRate += hit
+				break
+			}
+		}
+	}
+	n := float64(g.Scored)
+	g.HitRate = round(float64(hits)/n, 4)
+	if g.RangeScored > 0 {
+		g.RangeHitRate = round(float64(inRange)/float64(g.RangeScored), 4)
+	}
+	if positions > 0 {
+		g.WinRate = round(float64(wins)/float64(positions), 4)
+	}
+	g.AvgConfidence = round(conf/n, 4)
+	g.Brier = round(brier/n, 4)
+	g.AvgActualPct = round(actual/n, 3)
+	g.AvgReturnPct = round(ret/n, 3)
+	g.TotalReturnPct = round(ret, 3)
+	for _, b := range buckets {
+		if b.Count == 0 {
+			continue
+		}
+		b.AvgConfidence = round(b.AvgConfidence/float64(b.Count), 4)
+		b.HitRate = round(b.HitRate/float64(b.Count), 4)
+		g.Calibration = append(g.Calibration, b)
+	}
+}
+
+// Save 写入数据目录 backtest/<id>.json，返回路径
+func (r *Report) Save() (string, error) {
+	path := storage.Path("backtest", r.ID+".json")
+	if err := storage.WriteJSON(path, r); err != nil {
+		return "", fmt.Errorf("保存回测报告: %w", err)
+	}
+	return path, nil
+}
+
+// Markdown 报告摘要：按模型与模板版本列出命中率、校准与收益
+func (r *Report) Markdown() string {
+	c := r.Config
+	var b strings.Builder
+	fmt.Fprintf(&b, "# 预测回测报告 %s\n\n", r.ID)
+	fmt.Fprintf(&b, "- 股票：%s\n- 预测日：%s ~ %s，每 %d 个交易日一次\n- 预测周期：%d 个交易日，实际涨跌幅在 ±%.2f%% 内视为震荡\n",
+		strings.Join(c.Codes, "、"), c.From, c.To, c.Step, c.Days, c.NeutralPct)
+	if c.Mode != "" {
+		fmt.Fprintf(&b, "- 模式：%s\n", c.Mode)
+	}
+	for _, g := range r.Groups {
+		name := g.Model
+		if name == "" {
+			name = "（默认模型）"
+		}
+		if g.TemplateVersion != "" {
+			name += " / " + g.TemplateVersion
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", name)
+		fmt.Fprintf(&b, "- 样本 %d：评估 %d，失败 %d，未解析出结论 %d\n", g.Samples, g.Scored, g.Failed, g.Unparsed)
+		if g.Scored == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "- 预测方向：看多 %d、看空 %d、震荡 %d\n", g.Directions.Bullish, g.Directions.Bearish, g.Directions.Neutral)
+		fmt.Fprintf(&b, "- 方向命中率 %.1f%%，平均置信度 %.2f，Brier %.4f\n", g.HitRate*100, g.AvgConfidence, g.Brier)
+		if g.RangeScored > 0 {
+			fmt.Fprintf(&b, "- 实际收盘落入预计区间 %.1f%%（%d 次有区间）\n", g.RangeHitRate*100, g.RangeScored)
+		}
+		fmt.Fprintf(&b, "- 按结论操作：平均每次 %+.2f%%，累计 %+.2f%%，持仓胜率 %.1f%%；同期标的平均涨跌 %+.2f%%\n",
+			g.AvgReturnPct, g.TotalReturnPct, g.WinRate*100, g.AvgActualPct)
+		if len(g.Calibration) > 0 {
+			b.WriteString("- 置信度校准：\n")
+			for _, k := range g.Calibration {
+				fmt.Fprintf(&b, "  - %.1f～%.1f：%d 次，平均置信度 %.2f，实际命中率 %.1f%%\n",
+					k.Low, k.High, k.Count, k.AvgConfidence, k.HitRate*100)
+			}
+		}
+	}
+	return b.String()
+}
+
+func round(v float64, digits int) float64 {
+	p := math.Pow(10, float64(digits))
+	return math.Round(v*p) / p
+}
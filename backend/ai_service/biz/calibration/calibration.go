@@ -0,0 +1,409 @@
+// Package calibration 置信度校准：用已评估的预测（线上预测记录按其后实际收盘评估、回测报告中的样本）
+// 为每个模型/模板版本拟合“原始置信度 → 实际命中率”的单调映射（保序回归），在返回预测时替换模型自报的置信度。
+package calibration
+
+import (
+	"context"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/history"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+// PriorConfidence 既无校准数据、模型也未给出置信度时的取值：三类方向随机猜中的概率
+const PriorConfidence = 1.0 / 3
+
+// smoothing 保序回归各段向该组基准命中率收缩的伪样本数，避免小样本段出现 0 或 1
+const smoothing = 2
+
+// ActualDirection 按实际涨跌幅（%）判定方向，绝对值不超过 neutralPct 为震荡
+func ActualDirection(changePct, neutralPct float64) string {
+	switch {
+	case changePct > neutralPct:
+		return quant.DirectionBullish
+	case changePct < -neutralPct:
+		return quant.DirectionBearish
+	default:
+		return quant.DirectionNeutral
+	}
+}
+
+// Outcome 一次已评估的预测
+type Outcome struct {
+	Model           string
+	TemplateVersion string
+	Raw             float64 // 模型给出的原始置信度，0 表示未给出
+	Hit             bool    // 方向是否正确
+}
+
+// Point 校准曲线上的点：原始置信度与校准后的命中概率，点之间线性插值
+type Point struct {
+	Raw        float64 `json:"raw"`
+	Calibrated float64 `json:"calibrated"`
+}
+
+// Bin 可靠性图的一格：原始置信度落在 [Low, High) 的预测（最后一格含 1）
+type Bin struct {
+	Low           float64 `json:"low"`
+	High          float64 `json:"high"`
+	Count         int     `json:"count"`
+	AvgRaw        float64 `json:"avg_raw"`
+	AvgCalibrated float64 `json:"avg_calibrated"`
+	HitRate       float64 `json:"hit_rate"`
+}
+
+// Group 一个模型/模板版本的校准结果；TemplateVersion 为空表示该模型全部模板合并，供未单独拟合的模板使用
+type Group struct {
+	Model           string  `json:"model"`
+	TemplateVersion string  `json:"template_version,omitempty"`
+	Samples         int     `json:"samples"`
+	Rated           int     `json:"rated"`     // 其中给出原始置信度的样本数
+	BaseRate        float64 `json:"base_rate"` // 整体命中率，未给出置信度时使用
+	BrierRaw        float64 `json:"brier_raw"` // 样本内 Brier 分数（原始 / 校准后），越小越好
+	BrierCalibrated float64 `json:"brier_calibrated"`
+	Curve           []Point `json:"curve"` // 原始置信度样本不足时为空，此时只提供 BaseRate
+	Bins            []*Bin  `json:"bins"`
+}
+
+// apply 校准原始置信度；raw<=0 时取基准命中率，无校准曲线时 ok 为 false
+func (g *Group) apply(raw float64) (float64, bool) {
+	if raw <= 0 {
+		return g.BaseRate, true
+	}
+	pts := g.Curve
+	if len(pts) == 0 {
+		return 0, false
+	}
+	if raw <= pts[0].Raw {
+		return pts[0].Calibrated, true
+	}
+	for i := 1; i < len(pts); i++ {
+		if raw <= pts[i].Raw {
+			a, b := pts[i-1], pts[i]
+			return round(a.Calibrated + (b.Calibrated-a.Calibrated)*(raw-a.Raw)/(b.Raw-a.Raw)), true
+		}
+	}
+	return pts[len(pts)-1].Calibrated, true
+}
+
+// State 一次拟合的结果，保存于数据目录 calibration.json
+type State struct {
+	FittedAt   time.Time `json:"fitted_at"`
+	Samples    int       `json:"samples"` // 参与拟合的已评估预测数
+	Pending    int       `json:"pending"` // 尚未到期、无法评估的预测记录数
+	MinSamples int       `json:"min_samples"`
+	Groups     []*Group  `json:"groups"`
+}
+
+// Calibrator 校准映射的拟合、持久化与应用
+type Calibrator struct {
+	stockClient stockservice.Client
+	history     *history.Store
+	enabled     bool
+	minSamples  int
+	windowDays  int
+	neutralPct  float64
+	interval    time.Duration
+
+	mu     sync.RWMutex
+	state  *State
+	groups map[string]*Group
+
+	refitMu sync.Mutex
+}
+
+// NewFromEnv AI_CALIBRATION=off 时只拟合不应用；AI_CALIBRATION_MIN_SAMPLES 每组最少已评估样本（默认 30），
+// AI_CALIBRATION_WINDOW_DAYS 参与评估的预测记录天数（默认 180），AI_CALIBRATION_NEUTRAL_PCT 实际涨跌幅在此范围内视为震荡（默认 1），
+// AI_CALIBRATION_REFIT_HOURS 定时重新拟合间隔（默认 6，0 关闭）。启动时读取上次保存的拟合结果。
+func NewFromEnv(stockClient stockservice.Client, hist *history.Store) *Calibrator {
+	c := &Calibrator{
+		stockClient: stockClient,
+		history:     hist,
+		enabled:     !strings.EqualFold(strings.TrimSpace(os.Getenv("AI_CALIBRATION")), "off"),
+		minSamples:  envInt("AI_CALIBRATION_MIN_SAMPLES", 30),
+		windowDays:  envInt("AI_CALIBRATION_WINDOW_DAYS", 180),
+		neutralPct:  1,
+		interval:    time.Duration(envInt("AI_CALIBRATION_REFIT_HOURS", 6)) * time.Hour,
+	}
+	if s := strings.TrimSpace(os.Getenv("AI_CALIBRATION_NEUTRAL_PCT")); s != "" {
+		if v, err := strconv.ParseFloat(s, 64); err == nil && v >= 0 {
+			c.neutralPct = v
+		}
+	}
+	var st State
+	if err := storage.ReadJSON(statePath(), &st); err == nil {
+		c.set(&st)
+	} else if !os.IsNotExist(err) {
+		log.Printf("[calibration] 读取校准结果失败: %v", err)
+	}
+	return c
+}
+
+func envInt(key string, def int) int {
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv(key))); err == nil && n >= 0 {
+		return n
+	}
+	return def
+}
+
+func statePath() string { return storage.Path("calibration.json") }
+
+func groupKey(model, version string) string { return model + "|" + version }
+
+func (c *Calibrator) set(st *State) {
+	groups := make(map[string]*Group, len(st.Groups))
+	for _, g := range st.Groups {
+		groups[groupKey(g.Model, g.TemplateVersion)] = g
+	}
+	c.mu.Lock()
+	c.state, c.groups = st, groups
+	c.mu.Unlock()
+}
+
+// Apply 返回校准后的置信度：依次使用 (模型, 模板版本)、(模型, 全部模板) 的校准结果。
+// 无可用校准时原样返回 raw（raw<=0 时为 PriorConfidence），calibrated 为 false。
+func (c *Calibrator) Apply(model, templateVersion string, raw float64) (confidence float64, calibrated bool) {
+	if c.enabled {
+		c.mu.RLock()
+		candidates := []*Group{c.groups[groupKey(model, templateVersion)], c.groups[groupKey(model, "")]}
+		c.mu.RUnlock()
+		for _, g := range candidates {
+			if g == nil {
+				continue
+			}
+			if v, ok := g.apply(raw); ok {
+				return v, true
+			}
+		}
+	}
+	if raw <= 0 {
+		return PriorConfidence, false
+	}
+	return raw, false
+}
+
+// Accuracy 模型（全部模板合并）的历史方向命中率与已评估样本数，样本不足 AI_CALIBRATION_MIN_SAMPLES 时 ok 为 false
+func (c *Calibrator) Accuracy(model string) (rate float64, samples int, ok bool) {
+	c.mu.RLock()
+	g := c.groups[groupKey(model, "")]
+	c.mu.RUnlock()
+	if g == nil {
+		return 0, 0, false
+	}
+	return g.BaseRate, g.Samples, true
+}
+
+// State 最近一次拟合结果，从未拟合时为 nil
+func (c *Calibrator) State() *State {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// Start 后台定时重新拟合；上次拟合已超过间隔（或从未拟合）时立即拟合一次。间隔为 0 时不启动。
+func (c *Calibrator) Start() {
+	if c.interval <= 0 {
+		return
+	}
+	go func() {
+		if st := c.State(); st == nil || time.Since(st.FittedAt) >= c.interval {
+			c.refitLogged()
+		}
+		for range time.Tick(c.interval) {
+			c.refitLogged()
+		}
+	}()
+}
+
+func (c *Calibrator) refitLogged() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	if st, err := c.Refit(ctx); err != nil {
+		log.Printf("[calibration] 拟合失败: %v", err)
+	} else {
+		log.Printf("[calibration] 拟合完成: samples=%d pending=%d groups=%d", st.Samples, st.Pending, len(st.Groups))
+	}
+}
+
+// Refit 重新评估预测记录、读取回测样本并拟合，结果保存并立即生效；并发调用时串行执行。
+func (c *Calibrator) Refit(ctx context.Context) (*State, error) {
+	c.refitMu.Lock()
+	defer c.refitMu.Unlock()
+	outcomes, pending, err := c.scoreHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	outcomes = append(outcomes, backtestOutcomes()...)
+	st := &State{
+		FittedAt:   time.Now(),
+		Samples:    len(outcomes),
+		Pending:    pending,
+		MinSamples: c.minSamples,
+		Groups:     Fit(outcomes, c.minSamples),
+	}
+	if err := storage.WriteJSON(statePath(), st); err != nil {
+		log.Printf("[calibration] 保存校准结果失败: %v", err)
+	}
+	c.set(st)
+	return st, nil
+}
+
+// Fit 按 (模型, 模板版本) 及 (模型, 全部模板) 分组拟合，样本数不足 minSamples 的组跳过。
+func Fit(outcomes []Outcome, minSamples int) []*Group {
+	type bucket struct {
+		model, version string
+		list           []Outcome
+	}
+	var order []string
+	buckets := map[string]*bucket{}
+	add := func(model, version string, o Outcome) {
+		k := groupKey(model, version)
+		b, ok := buckets[k]
+		if !ok {
+			b = &bucket{model: model, version: version}
+			buckets[k] = b
+			order = append(order, k)
+		}
+		b.list = append(b.list, o)
+	}
+	for _, o := range outcomes {
+		add(o.Model, o.TemplateVersion, o)
+		if o.TemplateVersion != "" {
+			add(o.Model, "", o)
+		}
+	}
+	sort.Strings(order)
+	groups := []*Group{}
+	for _, k := range order {
+		b := buckets[k]
+		if len(b.list) < max(minSamples, 1) {
+			continue
+		}
+		groups = append(groups, fitGroup(b.model, b.version, b.list, minSamples))
+	}
+	return groups
+}
+
+func fitGroup(model, version string, list []Outcome, minSamples int) *Group {
+	g := &Group{Model: model, TemplateVersion: version, Samples: len(list), Bins: []*Bin{}}
+	hits := 0
+	var rated []Outcome
+	for _, o := range list {
+		if o.Hit {
+			hits++
+		}
+		if o.Raw > 0 {
+			rated = append(rated, o)
+		}
+	}
+	g.BaseRate = round(float64(hits) / float64(len(list)))
+	g.Rated = len(rated)
+	if len(rated) >= max(minSamples, 1) {
+		g.Curve = isotonic(rated, g.BaseRate)
+	}
+
+	bins := make([]*Bin, 10)
+	for i := range bins {
+		bins[i] = &Bin{Low: float64(i) / 10, High: float64(i+1) / 10}
+	}
+	var brierRaw, brierCal float64
+	for _, o := range rated {
+		y := 0.0
+		if o.Hit {
+			y = 1
+		}
+		cal, ok := g.apply(o.Raw)
+		if !ok {
+			cal = o.Raw
+		}
+		brierRaw += (o.Raw - y) * (o.Raw - y)
+		brierCal += (cal - y) * (cal - y)
+		b := bins[min(int(o.Raw*10), 9)]
+		b.Count++
+		b.AvgRaw += o.Raw
+		b.AvgCalibrated += cal
+		b.HitRate += y
+	}
+	if n := float64(len(rated)); n > 0 {
+		g.BrierRaw, g.BrierCalibrated = round(brierRaw/n), round(brierCal/n)
+	}
+	for _, b := range bins {
+		if b.Count == 0 {
+			continue
+		}
+		n := float64(b.Count)
+		b.AvgRaw, b.AvgCalibrated, b.HitRate = round(b.AvgRaw/n), round(b.AvgCalibrated/n), round(b.HitRate/n)
+		g.Bins = append(g.Bins, b)
+	}
+	return g
+}
+
+// isotonic 保序回归（PAV）：按原始置信度（保留两位小数）聚合后合并违反单调性的相邻段，
+// 各段向 base 收缩 smoothing 个伪样本后再次保序，返回各段的 (平均原始置信度, 命中概率)。
+func isotonic(list []Outcome, base float64) []Point {
+	type block struct{ sumX, sumY, w float64 }
+	byRaw := map[float64]*block{}
+	for _, o := range list {
+		x := math.Round(o.Raw*100) / 100
+		b, ok := byRaw[x]
+		if !ok {
+			b = &block{}
+			byRaw[x] = b
+		}
+		b.sumX += o.Raw
+		b.w++
+		if o.Hit {
+			b.sumY++
+		}
+	}
+	xs := make([]float64, 0, len(byRaw))
+	for x := range byRaw {
+		xs = append(xs, x)
+	}
+	sort.Float64s(xs)
+	blocks := make([]block, 0, len(xs))
+	for _, x := range xs {
+		blocks = append(blocks, *byRaw[x])
+	}
+
+	pav := func(in []block) []block {
+		var out []block
+		for _, b := range in {
+			out = append(out, b)
+			for len(out) > 1 {
+				p, q := out[len(out)-2], out[len(out)-1]
+				if p.sumY/p.w <= q.sumY/q.w {
+					break
+				}
+				out = append(out[:len(out)-2], block{p.sumX + q.sumX, p.sumY + q.sumY, p.w + q.w})
+			}
+		}
+		return out
+	}
+	blocks = pav(blocks)
+	for i := range blocks {
+		b := &blocks[i]
+		// 收缩：命中数与权重各加 smoothing 个以 base 命中的伪样本，平均原始置信度不变
+		b.sumX += smoothing * b.sumX / b.w
+		b.sumY += smoothing * base
+		b.w += smoothing
+	}
+	blocks = pav(blocks)
+
+	pts := make([]Point, 0, len(blocks))
+	for _, b := range blocks {
+		pts = append(pts, Point{Raw: round(b.sumX / b.w), Calibrated: round(b.sumY / b.w)})
+	}
+	return pts
+}
+
+func round(v float64) float64 { return math.Round(v*1e4) / 1e4 }
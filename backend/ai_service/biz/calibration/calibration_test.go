@@ -0,0 +1,154 @@
+package calibration
+
+import (
+	"math"
+	"testing"
+)
+
+// outcomes n 个原始置信度为 raw 的样本，其中前 hits 个命中
+func outcomes(model, version string, raw float64, n, hits int) []Outcome {
+	out := make([]Outcome, n)
+	for i := range out {
+		out[i] = Outcome{Model: model, TemplateVersion: version, Raw: raw, Hit: i < hits}
+	}
+	return out
+}
+
+func TestIsotonic(t *testing.T) {
+	var list []Outcome
+	// 0.5 与 0.6 的命中率倒挂，须合并为一段
+	list = append(list, outcomes("m", "", 0.3, 10, 2)...)
+	list = append(list, outcomes("m", "", 0.5, 10, 8)...)
+	list = append(list, outcomes("m", "", 0.6, 10, 4)...)
+	list = append(list, outcomes("m", "", 0.9, 10, 9)...)
+	pts := isotonic(list, 0.5)
+	if len(pts) != 3 {
+		t.Fatalf("points = %+v, want the inverted pair pooled into 3 blocks", pts)
+	}
+	for i := 1; i < len(pts); i++ {
+		if pts[i].Raw <= pts[i-1].Raw || pts[i].Calibrated < pts[i-1].Calibrated {
+			t.Errorf("points not monotonic: %+v", pts)
+		}
+	}
+	// 合并段：(12 + 2×0.5) / (20 + 2)
+	if want := round(13.0 / 22); pts[1].Calibrated != want || pts[1].Raw != 0.55 {
+		t.Errorf("pooled block = %+v, want raw 0.55 calibrated %v", pts[1], want)
+	}
+}
+
+func TestIsotonicShrinkage(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		hits, n    int
+		base, want float64
+	}{
+		{"all hits pulled below 1", 4, 4, 0.5, (4 + smoothing*0.5) / (4 + smoothing)},
+		{"all misses pulled above 0", 0, 4, 0.5, smoothing * 0.5 / (4 + smoothing)},
+		{"toward a low base rate", 4, 4, 0.2, (4 + smoothing*0.2) / (4 + smoothing)},
+		{"large sample barely moves", 90, 100, 0.5, (90 + smoothing*0.5) / (100 + smoothing)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pts := isotonic(outcomes("m", "", 0.7, tt.n, tt.hits), tt.base)
+			if len(pts) != 1 || pts[0].Calibrated != round(tt.want) || pts[0].Raw != 0.7 {
+				t.Errorf("points = %+v, want calibrated %.4f", pts, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupApply(t *testing.T) {
+	g := &Group{BaseRate: 0.45, Curve: []Point{{Raw: 0.4, Calibrated: 0.3}, {Raw: 0.6, Calibrated: 0.5}, {Raw: 0.8, Calibrated: 0.7}}}
+	for _, tt := range []struct {
+		raw, want float64
+	}{
+		{0, 0.45},    // 未给出置信度取基准命中率
+		{-1, 0.45},   // 同上
+		{0.2, 0.3},   // 低于曲线起点
+		{0.4, 0.3},   // 端点
+		{0.5, 0.4},   // 插值
+		{0.75, 0.65}, // 插值
+		{0.8, 0.7},   // 端点
+		{0.95, 0.7},  // 高于曲线终点
+	} {
+		got, ok := g.apply(tt.raw)
+		if !ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("apply(%v) = %v, %v, want %v", tt.raw, got, ok, tt.want)
+		}
+	}
+	noCurve := &Group{BaseRate: 0.45}
+	if _, ok := noCurve.apply(0.6); ok {
+		t.Error("group without curve calibrated a rated prediction")
+	}
+	if got, ok := noCurve.apply(0); !ok || got != 0.45 {
+		t.Errorf("unrated without curve = %v, %v, want base rate", got, ok)
+	}
+}
+
+func TestFit(t *testing.T) {
+	var list []Outcome
+	list = append(list, outcomes("m", "prediction@v1", 0.8, 6, 5)...)
+	list = append(list, outcomes("m", "prediction@v1", 0.4, 4, 1)...)
+	list = append(list, outcomes("m", "prediction@v2", 0.6, 2, 1)...)
+	list = append(list, outcomes("n", "", 0, 5, 2)...)
+	groups := Fit(list, 5)
+
+	var keys []string
+	byKey := map[string]*Group{}
+	for _, g := range groups {
+		keys = append(keys, groupKey(g.Model, g.TemplateVersion))
+		byKey[groupKey(g.Model, g.TemplateVersion)] = g
+	}
+	// v2 样本不足单独拟合，只计入 (m, 全部模板)
+	if want := []string{"m|", "m|prediction@v1", "n|"}; len(keys) != len(want) || keys[0] != want[0] || keys[1] != want[1] || keys[2] != want[2] {
+		t.Fatalf("groups = %v, want %v", keys, want)
+	}
+	if g := byKey["m|"]; g.Samples != 12 || g.Rated != 12 || g.BaseRate != round(7.0/12) {
+		t.Errorf("merged group = %+v", g)
+	}
+	if g := byKey["m|prediction@v1"]; len(g.Curve) != 2 || g.Curve[0].Calibrated >= g.Curve[1].Calibrated || len(g.Bins) != 2 {
+		t.Errorf("v1 group = %+v", g)
+	}
+	// 只有未给出置信度的样本：无曲线，只提供基准命中率
+	if g := byKey["n|"]; g.Rated != 0 || len(g.Curve) != 0 || g.BaseRate != 0.4 {
+		t.Errorf("unrated group = %+v", g)
+	}
+
+	// 插值：曲线两点之间按原始置信度线性插值
+	v1 := byKey["m|prediction@v1"]
+	a, b := v1.Curve[0], v1.Curve[1]
+	mid := (a.Raw + b.Raw) / 2
+	if got, _ := v1.apply(mid); math.Abs(got-(a.Calibrated+b.Calibrated)/2) > 1e-4 {
+		t.Errorf("apply(%v) = %v, want midpoint of %+v", mid, got, v1.Curve)
+	}
+}
+
+func TestApplyFallback(t *testing.T) {
+	c := &Calibrator{enabled: true}
+	c.set(&State{Groups: []*Group{
+		{Model: "m", TemplateVersion: "prediction@v1", BaseRate: 0.6, Curve: []Point{{Raw: 0.5, Calibrated: 0.55}}},
+		{Model: "m", BaseRate: 0.4, Curve: []Point{{Raw: 0.5, Calibrated: 0.35}}},
+		{Model: "n", BaseRate: 0.5}, // 无曲线
+	}})
+	for _, tt := range []struct {
+		model, version string
+		raw, want      float64
+		calibrated     bool
+	}{
+		{"m", "prediction@v1", 0.5, 0.55, true},
+		{"m", "prediction@v2", 0.5, 0.35, true}, // 未单独拟合的模板使用 (m, 全部模板)
+		{"m", "prediction@v2", 0, 0.4, true},
+		{"n", "prediction@v1", 0.7, 0.7, false}, // 无曲线时原样返回
+		{"n", "", 0, 0.5, true},
+		{"x", "", 0.7, 0.7, false},
+		{"x", "", 0, PriorConfidence, false},
+	} {
+		got, ok := c.Apply(tt.model, tt.version, tt.raw)
+		if got != tt.want || ok != tt.calibrated {
+			t.Errorf("Apply(%s, %s, %v) = %v, %v, want %v, %v", tt.model, tt.version, tt.raw, got, ok, tt.want, tt.calibrated)
+		}
+	}
+	c.enabled = false
+	if got, ok := c.Apply("m", "prediction@v1", 0.5); got != 0.5 || ok {
+		t.Errorf("disabled Apply = %v, %v, want raw", got, ok)
+	}
+}
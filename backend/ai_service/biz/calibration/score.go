@@ -0,0 +1,126 @@
+package calibration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/history"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// hkLoc 港股交易日按香港时间划分
+var hkLoc = func() *time.Location {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		return time.FixedZone("HKT", 8*3600)
+	}
+	return loc
+}()
+
+// scoreHistory 评估窗口内的预测记录：以预测时现价为入场价、预测日之后第 Days 个交易日的收盘为出场价判定方向是否正确。
+// 尚未到期的记录计入 pending；单只股票 K 线获取失败时跳过该股票。
+func (c *Calibrator) scoreHistory(ctx context.Context) ([]Outcome, int, error) {
+	since := time.Now().AddDate(0, 0, -c.windowDays)
+	recs, err := c.history.List(func(r *history.Record) bool {
+		return r.Time.After(since) && r.Direction != "" && r.Price > 0
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("读取预测记录: %w", err)
+	}
+	byCode := map[string][]*history.Record{}
+	var codes []string
+	for _, r := range recs {
+		if _, ok := byCode[r.Code]; !ok {
+			codes = append(codes, r.Code)
+		}
+		byCode[r.Code] = append(byCode[r.Code], r)
+	}
+
+	var outcomes []Outcome
+	pending := 0
+	for _, code := range codes {
+		list := byCode[code]
+		// 自最早一条记录至今的日历天数足以覆盖所需交易日
+		limit := int(time.Since(list[0].Time).Hours()/24) + 10
+		resp, err := c.stockClient.GetKline(ctx, &stock.GetKlineRequest{Code: code, Period: "day", Limit: int32(limit)})
+		if err != nil || resp == nil || len(resp.Klines) == 0 {
+			log.Printf("[calibration] 获取 %s 日 K 失败，跳过 %d 条记录: %v", code, len(list), err)
+			continue
+		}
+		bars := resp.Klines
+		for _, r := range list {
+			date := r.Time.In(hkLoc).Format("2006-01-02")
+			next := sort.Search(len(bars), func(i int) bool { return bars[i].Date > date })
+			days := int(r.Days)
+			if days <= 0 {
+				days = 3
+			}
+			if next+days-1 >= len(bars) {
+				pending++
+				continue
+			}
+			exit := bars[next+days-1].Close
+			actual := ActualDirection((exit/r.Price-1)*100, c.neutralPct)
+			raw := r.Confidence
+			if r.RawConfidence != nil {
+				raw = *r.RawConfidence
+			}
+			outcomes = append(outcomes, Outcome{Model: r.Model, TemplateVersion: r.TemplateVersion, Raw: raw, Hit: r.Direction == actual})
+		}
+	}
+	return outcomes, pending, nil
+}
+
+// backtestSample 回测报告（数据目录 backtest/<id>.json）中参与校准的字段
+type backtestSample struct {
+	Code            string   `json:"code"`
+	AsOf            string   `json:"as_of"`
+	Model           string   `json:"model"`
+	TemplateVersion string   `json:"template_version"`
+	Confidence      float64  `json:"confidence"`
+	RawConfidence   *float64 `json:"raw_confidence"` // 早期报告无此字段，其 confidence 即原始值
+	Direction       string   `json:"direction"`
+	Hit             bool     `json:"hit"`
+	Error           string   `json:"error"`
+}
+
+// backtestOutcomes 读取全部回测报告的已评估样本；同一股票、预测日、模型与模板的重复样本只保留最新报告中的一条
+func backtestOutcomes() []Outcome {
+	files, _ := filepath.Glob(filepath.Join(storage.Dir("backtest"), "*.json"))
+	sort.Strings(files) // 报告 ID 按时间有序
+	latest := map[string]Outcome{}
+	var keys []string
+	for _, f := range files {
+		var rep struct {
+			Samples []backtestSample `json:"samples"`
+		}
+		if err := storage.ReadJSON(f, &rep); err != nil {
+			log.Printf("[calibration] 读取回测报告 %s 失败: %v", f, err)
+			continue
+		}
+		for _, s := range rep.Samples {
+			if s.Error != "" || s.Direction == "" {
+				continue
+			}
+			raw := s.Confidence
+			if s.RawConfidence != nil {
+				raw = *s.RawConfidence
+			}
+			k := s.Code + "|" + s.AsOf + "|" + s.Model + "|" + s.TemplateVersion
+			if _, ok := latest[k]; !ok {
+				keys = append(keys, k)
+			}
+			latest[k] = Outcome{Model: s.Model, TemplateVersion: s.TemplateVersion, Raw: raw, Hit: s.Hit}
+		}
+	}
+	out := make([]Outcome, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, latest[k])
+	}
+	return out
+}
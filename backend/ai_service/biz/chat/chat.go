@@ -0,0 +1,235 @@
+// Package chat 个股追问会话：以预测时的数据快照与结论为背景，保存有界的对话历史并持久化到本地（数据目录下 chat/<id>.json）。
+package chat
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+)
+
+// ErrNotFound 会话不存在
+var ErrNotFound error = i18n.Errorf(i18n.MsgChatNotFound)
+
+// Message 一条对话消息（role 为 user 或 assistant）
+type Message struct {
+	Role    string    `json:"role"`
+	Content string    `json:"content"`
+	Time    time.Time `json:"time"`
+}
+
+// Session 追问会话
+type Session struct {
+	ID           string    `json:"id"`
+	Code         string    `json:"code"`
+	PredictionID string    `json:"prediction_id,omitempty"`
+	Model        string    `json:"model,omitempty"`
+	Context      string    `json:"context"`            // 会话开始时的数据快照
+	Analysis     string    `json:"analysis,omitempty"` // 由预测创建时为该次预测的分析结论
+	Messages     []Message `json:"messages"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// idRe 会话 ID 只允许 storage.NewID 生成的字符，避免拼接文件路径时越界
+var idRe = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// Store 会话存储：内存缓存 + 每个会话一个 JSON 文件。文件为准，缓存只保留最近使用的会话。
+type Store struct {
+	maxMessages int
+	maxCached   int
+	idle        time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*cached
+	turns map[string]*turn
+}
+
+// cached 缓存中的会话及最近访问时间
+type cached struct {
+	sess *Session
+	used time.Time
+}
+
+// turn 会话轮次锁；refs 为持有或等待该锁的调用数，归零时从 turns 删除
+type turn struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// NewStore 创建会话存储；AI_CHAT_MAX_MESSAGES 限制每个会话保存的消息数（默认 40，超出丢弃最早的），
+// 内存中最多缓存 AI_CHAT_CACHE_SIZE 个会话（默认 200），闲置超过 AI_CHAT_IDLE_MIN 分钟（默认 30）的移出缓存。
+func NewStore() *Store {
+	return &Store{
+		maxMessages: envInt("AI_CHAT_MAX_MESSAGES", 40),
+		maxCached:   envInt("AI_CHAT_CACHE_SIZE", 200),
+		idle:        time.Duration(envInt("AI_CHAT_IDLE_MIN", 30)) * time.Minute,
+		cache:       map[string]*cached{},
+		turns:       map[string]*turn{},
+	}
+}
+
+func envInt(key string, def int) int {
+	if s := strings.TrimSpace(os.Getenv(key)); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n > 0 {
+			return n
+		}
+	}
+	return def
+}
+
+func path(id string) string { return storage.Path("chat", id+".json") }
+
+// Create 保存新会话，ID 与时间为空时自动填充
+func (s *Store) Create(sess *Session) error {
+	if sess.ID == "" {
+		sess.ID = storage.NewID()
+	}
+	now := time.Now()
+	if sess.CreatedAt.IsZero() {
+		sess.CreatedAt = now
+	}
+	sess.UpdatedAt = now
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := storage.WriteJSON(path(sess.ID), sess); err != nil {
+		return fmt.Errorf("保存会话: %w", err)
+	}
+	s.put(sess)
+	return nil
+}
+
+// put 放入缓存，并移出闲置超时的会话；仍超过上限时移出最久未用的，调用方持有 s.mu
+func (s *Store) put(sess *Session) {
+	now := time.Now()
+	s.cache[sess.ID] = &cached{sess: sess, used: now}
+	var oldest string
+	for id, c := range s.cache {
+		if now.Sub(c.used) > s.idle {
+			delete(s.cache, id)
+		} else if oldest == "" || c.used.Before(s.cache[oldest].used) {
+			oldest = id
+		}
+	}
+	if len(s.cache) > s.maxCached {
+		delete(s.cache, oldest)
+	}
+}
+
+// Get 读取会话（返回副本），不存在时返回 ErrNotFound
+func (s *Store) Get(id string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, err := s.load(id)
+	if err != nil {
+		return nil, err
+	}
+	cp := *sess
+	cp.Messages = append([]Message(nil), sess.Messages...)
+	return &cp, nil
+}
+
+// load 从缓存或文件加载，调用方持有 s.mu
+func (s *Store) load(id string) (*Session, error) {
+	if !idRe.MatchString(id) {
+		return nil, ErrNotFound
+	}
+	if c, ok := s.cache[id]; ok {
+		c.used = time.Now()
+		return c.sess, nil
+	}
+	var sess Session
+	if err := storage.ReadJSON(path(id), &sess); err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("读取会话: %w", err)
+	}
+	s.put(&sess)
+	return &sess, nil
+}
+
+// Append 追加消息并持久化；超过上限时丢弃最早的消息
+func (s *Store) Append(id string, msgs ...Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, err := s.load(id)
+	if err != nil {
+		return err
+	}
+	sess.Messages = append(sess.Messages, msgs...)
+	if n := len(sess.Messages) - s.maxMessages; n > 0 {
+		sess.Messages = append([]Message(nil), sess.Messages[n:]...)
+	}
+	sess.UpdatedAt = time.Now()
+	if err := storage.WriteJSON(path(id), sess); err != nil {
+		return fmt.Errorf("保存会话: %w", err)
+	}
+	return nil
+}
+
+// Lock 串行化同一会话的对话轮次，返回解锁函数；没有调用持有或等待时锁即被回收
+func (s *Store) Lock(id string) func() {
+	s.mu.Lock()
+	t, ok := s.turns[id]
+	if !ok {
+		t = &turn{}
+		s.turns[id] = t
+	}
+	t.refs++
+	s.mu.Unlock()
+	t.mu.Lock()
+	return func() {
+		t.mu.Unlock()
+		s.mu.Lock()
+		if t.refs--; t.refs == 0 {
+			delete(s.turns, id)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// EstimateTokens 粗略估算 token 数：中日韩等非 ASCII 字符约 1 token/字，ASCII 约 4 字符/token
+func EstimateTokens(text string) int {
+	ascii, other := 0, 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return other + (ascii+3)/4
+}
+
+// Window 组装发送给模型的消息：system 在前，其后为能放进 budget 的最近若干条消息（至少保留最后一条）。
+func Window(system string, history []Message, budget int) []llm.Message {
+	used := EstimateTokens(system)
+	start := len(history)
+	for start > 0 {
+		t := EstimateTokens(history[start-1].Content)
+		if start < len(history) && used+t > budget {
+			break
+		}
+		used += t
+		start--
+	}
+	// 不以 assistant 开头，保持 user/assistant 交替
+	for start < len(history)-1 && history[start].Role != "user" {
+		start++
+	}
+	out := make([]llm.Message, 0, len(history)-start+1)
+	out = append(out, llm.Message{Role: "system", Content: system})
+	for _, m := range history[start:] {
+		out = append(out, llm.Message{Role: m.Role, Content: m.Content})
+	}
+	return out
+}
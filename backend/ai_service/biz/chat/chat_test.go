@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStoreEviction(t *testing.T) {
+	t.Setenv("AI_DATA_DIR", t.TempDir())
+	t.Setenv("AI_CHAT_CACHE_SIZE", "2")
+	s := NewStore()
+	var ids []string
+	for i := 0; i < 3; i++ {
+		sess := &Session{Code: "hk00700"}
+		if err := s.Create(sess); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, sess.ID)
+	}
+	if _, ok := s.cache[ids[0]]; ok || len(s.cache) != 2 {
+		t.Errorf("cache = %v, want the oldest of 3 evicted", s.cache)
+	}
+	// 移出缓存的会话从文件读取
+	if err := s.Append(ids[0], Message{Role: "user", Content: "q"}); err != nil {
+		t.Fatal(err)
+	}
+	if sess, err := s.Get(ids[0]); err != nil || len(sess.Messages) != 1 {
+		t.Fatalf("sess = %+v, err = %v", sess, err)
+	}
+
+	if _, ok := s.cache[ids[1]]; ok {
+		t.Error("least recently used session not evicted on reload")
+	}
+
+	s.cache[ids[2]].used = time.Now().Add(-time.Hour)
+	s.put(&Session{ID: "fresh"})
+	if _, ok := s.cache[ids[2]]; ok || len(s.cache) != 2 {
+		t.Errorf("cache = %v, want idle session evicted", s.cache)
+	}
+
+	unlock := s.Lock(ids[0])
+	done := make(chan struct{})
+	go func() { s.Lock(ids[0])(); close(done) }()
+	unlock()
+	<-done
+	if len(s.turns) != 0 {
+		t.Errorf("turns = %v, want released locks removed", s.turns)
+	}
+}
@@ -0,0 +1,97 @@
+// Package history 预测记录：每次 LLM 预测的输入要素、模板版本与结论，供按模板/模型比较效果。
+package history
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+)
+
+// Record 一条预测记录
+type Record struct {
+	ID              string    `json:"id"`
+	Time            time.Time `json:"time"`
+	Code            string    `json:"code"`
+	Days            int32     `json:"days"`
+	Model           string    `json:"model"`
+	TemplateVersion string    `json:"template_version"`
+	Mode            string    `json:"mode,omitempty"`
+	Price           float64   `json:"price"` // 预测时现价，0 表示未取到
+	Direction       string    `json:"direction,omitempty"`
+	Confidence      float64   `json:"confidence"`               // 返回给用户的置信度（已校准时为校准值）
+	RawConfidence   *float64  `json:"raw_confidence,omitempty"` // 模型给出的原始置信度，0 表示未给出；旧记录为 nil，其 Confidence 即原始值
+	PriceLow        float64   `json:"price_low,omitempty"`
+	PriceHigh       float64   `json:"price_high,omitempty"`
+}
+
+// Detail 预测详情：渲染进 prompt 的数据快照与完整分析，供追问会话等引用
+type Detail struct {
+	ID       string    `json:"id"`
+	Time     time.Time `json:"time"`
+	Code     string    `json:"code"`
+	Model    string    `json:"model"`
+	Context  string    `json:"context"`
+	Analysis string    `json:"analysis"`
+}
+
+// Store 预测记录存储（数据目录下 predictions.jsonl，追加写；详情另存 predictions/<id>.json）
+type Store struct {
+	path string
+}
+
+// NewStore 使用默认数据目录
+func NewStore() *Store {
+	return &Store{path: storage.Path("predictions.jsonl")}
+}
+
+// Append 追加记录；ID、Time 为空时自动填充
+func (s *Store) Append(rec *Record) error {
+	if rec.ID == "" {
+		rec.ID = storage.NewID()
+	}
+	if rec.Time.IsZero() {
+		rec.Time = time.Now()
+	}
+	return s.wrapErr(storage.AppendJSONL(s.path, rec))
+}
+
+// List 返回满足 filter 的记录（filter 为 nil 时返回全部），按写入顺序
+func (s *Store) List(filter func(*Record) bool) ([]*Record, error) {
+	var out []*Record
+	err := storage.ReadJSONL(s.path, func() interface{} { return &Record{} }, func(v interface{}) {
+		rec := v.(*Record)
+		if filter == nil || filter(rec) {
+			out = append(out, rec)
+		}
+	})
+	return out, s.wrapErr(err)
+}
+
+// SaveDetail 保存预测详情，d.ID 须为 Append 填充的记录 ID
+func (s *Store) SaveDetail(d *Detail) error {
+	return s.wrapErr(storage.WriteJSON(detailPath(d.ID), d))
+}
+
+// GetDetail 读取预测详情；不存在时返回的错误满足 os.IsNotExist
+func (s *Store) GetDetail(id string) (*Detail, error) {
+	if strings.ContainsAny(id, `/\.`) {
+		return nil, os.ErrNotExist
+	}
+	var d Detail
+	if err := storage.ReadJSON(detailPath(id), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func detailPath(id string) string { return storage.Path("predictions", id+".json") }
+
+func (s *Store) wrapErr(err error) error {
+	if err != nil {
+		return fmt.Errorf("prediction history %s: %w", s.path, err)
+	}
+	return nil
+}
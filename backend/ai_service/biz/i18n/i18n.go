@@ -0,0 +1,110 @@
+// Package i18n 预测输出语言与面向用户的错误文案：简体中文（默认）、繁体中文、英文。
+//
+// 需要按请求语言返回的错误用 Errorf/Wrap 创建，Error() 仍为简体中文（日志与未指定语言的调用方不变），
+// 返回给调用方前以 Localize 按语言取文案；其他错误原样返回。
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 支持的语言
+const (
+	ZhCN = "zh-CN" // 简体中文（默认）
+	ZhTW = "zh-TW" // 繁体中文（港台用语）
+	En   = "en"    // 英文
+)
+
+// Languages 全部支持的语言
+var Languages = []string{ZhCN, ZhTW, En}
+
+// Normalize 规范化语言标识：空为 zh-CN；接受 zh、zh-Hans、zh-HK、zh-Hant、en-US 等写法（不区分大小写，- 与 _ 均可）。
+func Normalize(lang string) (string, error) {
+	s := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+	switch {
+	case s == "" || s == "zh" || s == "zh-cn" || s == "zh-sg" || strings.HasPrefix(s, "zh-hans"):
+		return ZhCN, nil
+	case s == "zh-tw" || s == "zh-hk" || s == "zh-mo" || strings.HasPrefix(s, "zh-hant"):
+		return ZhTW, nil
+	case s == "en" || strings.HasPrefix(s, "en-"):
+		return En, nil
+	}
+	return "", Errorf(MsgUnsupportedLanguage, lang, strings.Join(Languages, ", "))
+}
+
+// Error 可本地化的错误
+type Error struct {
+	Key  string
+	Args []interface{}
+	Err  error // 被包装的原始错误，文案后附其内容
+}
+
+// Errorf 以消息 key 与参数创建错误
+func Errorf(key string, args ...interface{}) *Error {
+	return &Error{Key: key, Args: args}
+}
+
+// Wrap 以消息 key 包装 err，errors.Is/As 仍可识别 err
+func Wrap(err error, key string, args ...interface{}) *Error {
+	return &Error{Key: key, Args: args, Err: err}
+}
+
+func (e *Error) Error() string { return e.Text(ZhCN) }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Text 指定语言的文案
+func (e *Error) Text(lang string) string {
+	s := T(lang, e.Key, e.Args...)
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// Localize 错误链中有 *Error 时返回其 lang 文案，否则返回 err.Error()。lang 无法识别时按简体中文。
+func Localize(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+	var e *Error
+	if !errors.As(err, &e) {
+		return err.Error()
+	}
+	if l, nerr := Normalize(lang); nerr == nil {
+		lang = l
+	} else {
+		lang = ZhCN
+	}
+	return e.Text(lang)
+}
+
+// LocalizeError 同 Localize，返回 error（nil 原样返回），用于 RPC 返回值
+func LocalizeError(err error, lang string) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if !errors.As(err, &e) {
+		return err
+	}
+	return errors.New(Localize(err, lang))
+}
+
+// T 指定语言的文案；缺少该语言时用简体中文，未知 key 时返回 key 本身。
+func T(lang, key string, args ...interface{}) string {
+	m, ok := messages[key]
+	if !ok {
+		return key
+	}
+	format, ok := m[lang]
+	if !ok {
+		format = m[ZhCN]
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
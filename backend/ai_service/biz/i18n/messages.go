@@ -0,0 +1,363 @@
+package i18n
+
+// 消息 key
+const (
+	MsgUnsupportedLanguage = "unsupported_language"
+	MsgUnsupportedMode     = "unsupported_mode"
+	MsgEnsembleMode        = "ensemble_mode"
+	MsgEnsembleNoLLM       = "ensemble_no_llm"
+	MsgEnsembleTooMany     = "ensemble_too_many"
+	MsgEnsembleAllFailed   = "ensemble_all_failed"
+	MsgEmptyAnswer         = "empty_answer"
+	MsgQuantNeedsKline     = "quant_needs_kline"
+	MsgQuantTooFewBars     = "quant_too_few_bars"
+	MsgBudgetExceeded      = "budget_exceeded"
+	MsgRateLimited         = "rate_limited"
+	MsgNewsInAnalysis      = "news_in_analysis"
+	MsgNewsQuant           = "news_quant"
+	MsgLLMNotConfigured    = "llm_not_configured"
+	MsgCompareCodes        = "compare_codes"
+	MsgBatchNoCodes        = "batch_no_codes"
+	MsgBatchTooMany        = "batch_too_many"
+	MsgCodeRequired        = "code_required"
+	MsgDaysOutOfRange      = "days_out_of_range"
+	MsgContentRequired     = "content_required"
+	MsgChatNeedsCode       = "chat_needs_code"
+	MsgChatNotFound        = "chat_not_found"
+	MsgPredictionNotFound  = "prediction_not_found"
+	MsgJobNotFound         = "job_not_found"
+	MsgJobQueueFull        = "job_queue_full"
+	MsgJobCanceled         = "job_canceled"
+	MsgCompareQuant        = "compare_quant"
+	MsgDataQuoteFailed     = "data_quote_failed"
+	MsgDataMarketFailed    = "data_market_failed"
+	MsgDataKlineFailed     = "data_kline_failed"
+)
+
+// 输出文案 key（结论标签、汇总文档与数字核对告警），各语言的参数顺序同样须一致
+const (
+	MsgDirBullish        = "dir_bullish"
+	MsgDirBearish        = "dir_bearish"
+	MsgDirNeutral        = "dir_neutral"
+	MsgFullStop          = "full_stop"
+	MsgNamedItem         = "named_item"
+	MsgVerdictSummary    = "verdict_summary"
+	MsgDigestTitle       = "digest_title"
+	MsgDigestCounts      = "digest_counts"
+	MsgDigestUnratedN    = "digest_unrated_n"
+	MsgDigestFailedN     = "digest_failed_n"
+	MsgDigestBullish     = "digest_bullish"
+	MsgDigestBearish     = "digest_bearish"
+	MsgDigestTop         = "digest_top"
+	MsgDigestNeutral     = "digest_neutral"
+	MsgDigestUnrated     = "digest_unrated"
+	MsgDigestRisks       = "digest_risks"
+	MsgDigestFailed      = "digest_failed"
+	MsgRiskKeyword       = "risk_keyword"
+	MsgRiskBearish       = "risk_bearish"
+	MsgRiskWideRange     = "risk_wide_range"
+	MsgRiskOverbought    = "risk_overbought"
+	MsgRiskOversold      = "risk_oversold"
+	MsgRiskVolume        = "risk_volume"
+	MsgEnsembleMembers   = "ensemble_members"
+	MsgEnsembleMember    = "ensemble_member"
+	MsgEnsembleMemberAcc = "ensemble_member_acc"
+	MsgEnsembleFailed    = "ensemble_failed"
+	MsgEnsembleNoVerdict = "ensemble_no_verdict"
+	MsgEnsembleSummary   = "ensemble_summary"
+	MsgEnsembleVotes     = "ensemble_votes"
+	MsgEnsembleChange    = "ensemble_change"
+	MsgEnsemblePrice     = "ensemble_price"
+	MsgEnsembleSplit     = "ensemble_split"
+	MsgVerifyPrice       = "verify_price"
+	MsgVerifyChange      = "verify_change"
+	MsgVerifyVolume      = "verify_volume"
+	MsgVerifyIndex       = "verify_index"
+	MsgVerifyUnsupported = "verify_unsupported"
+	MsgRangeChangeOrder  = "range_change_order"
+	MsgRangePriceOrder   = "range_price_order"
+	MsgRangeBullish      = "range_bullish"
+	MsgRangeBearish      = "range_bearish"
+	MsgRangeTooWide      = "range_too_wide"
+	MsgRangeMismatch     = "range_mismatch"
+	MsgRangeBeyondBands  = "range_beyond_bands"
+)
+
+// messages key → 语言 → fmt 格式；各语言的参数顺序须一致
+var messages = map[string]map[string]string{
+	MsgUnsupportedLanguage: {
+		ZhCN: "不支持的语言: %s（可选 %s）",
+		ZhTW: "不支援的語言: %s（可選 %s）",
+		En:   "unsupported language: %s (supported: %s)",
+	},
+	MsgUnsupportedMode: {
+		ZhCN: "不支持的预测模式: %s（可选 %s、%s）",
+		ZhTW: "不支援的預測模式: %s（可選 %s、%s）",
+		En:   "unsupported prediction mode: %s (supported: %s, %s)",
+	},
+	MsgEnsembleMode: {
+		ZhCN: "多模型对比仅支持 %s 模式",
+		ZhTW: "多模型對比僅支援 %s 模式",
+		En:   "multi-model comparison only supports %s mode",
+	},
+	MsgEnsembleNoLLM: {
+		ZhCN: "未配置 LLM API Key，无法使用模型 %s",
+		ZhTW: "未設定 LLM API Key，無法使用模型 %s",
+		En:   "no LLM API key configured, model %s is unavailable",
+	},
+	MsgEnsembleTooMany: {
+		ZhCN: "最多同时对比 %d 个模型",
+		ZhTW: "最多同時對比 %d 個模型",
+		En:   "at most %d models can be compared at once",
+	},
+	MsgEnsembleAllFailed: {
+		ZhCN: "参与对比的模型均预测失败: %s",
+		ZhTW: "參與對比的模型均預測失敗: %s",
+		En:   "all compared models failed: %s",
+	},
+	MsgEmptyAnswer: {
+		ZhCN: "LLM 返回内容为空（可能触发内容策略或模型限制，请稍后重试或换用其他模型）",
+		ZhTW: "LLM 回傳內容為空（可能觸發內容政策或模型限制，請稍後重試或改用其他模型）",
+		En:   "the LLM returned an empty answer (possibly a content policy or model limit); please retry later or choose another model",
+	},
+	MsgQuantNeedsKline: {
+		ZhCN: "量化预测需要日 K 数据: %s",
+		ZhTW: "量化預測需要日 K 資料: %s",
+		En:   "the quant model needs daily K-line data: %s",
+	},
+	MsgQuantTooFewBars: {
+		ZhCN: "K 线不足 %d 根（当前 %d 根），无法计算量化信号",
+		ZhTW: "K 線不足 %d 根（目前 %d 根），無法計算量化訊號",
+		En:   "the quant model needs at least %d daily bars (got %d)",
+	},
+	MsgBudgetExceeded: {
+		ZhCN: "今日 LLM 费用 %.2f %s 已达预算 %.2f，暂停调用，请明日再试",
+		ZhTW: "今日 LLM 費用 %.2f %s 已達預算 %.2f，暫停呼叫，請明日再試",
+		En:   "today's LLM spend %.2f %s has reached the budget of %.2f; calls are paused until tomorrow",
+	},
+	MsgRateLimited: {
+		ZhCN: "LLM 服务繁忙，重试 %d 次后仍被限流，请稍后再试",
+		ZhTW: "LLM 服務繁忙，重試 %d 次後仍被限流，請稍後再試",
+		En:   "the LLM service is busy and still rate-limited after %d retries; please try again later",
+	},
+	MsgNewsInAnalysis: {
+		ZhCN: "参见分析内容。",
+		ZhTW: "參見分析內容。",
+		En:   "See the analysis.",
+	},
+	MsgNewsQuant: {
+		ZhCN: "规则量化模型不分析新闻。",
+		ZhTW: "規則量化模型不分析新聞。",
+		En:   "The rule-based quant model does not analyse news.",
+	},
+	MsgLLMNotConfigured: {
+		ZhCN: "未配置 LLM API Key，请设置环境变量 ZHIPU_API_KEY 或 LLM_API_KEY",
+		ZhTW: "未設定 LLM API Key，請設定環境變數 ZHIPU_API_KEY 或 LLM_API_KEY",
+		En:   "no LLM API key configured; set ZHIPU_API_KEY or LLM_API_KEY",
+	},
+	MsgCompareCodes: {
+		ZhCN: "多股对比需要 %d～%d 只不同的股票，当前 %d 只",
+		ZhTW: "多股對比需要 %d～%d 隻不同的股票，目前 %d 隻",
+		En:   "comparison needs %d to %d distinct stocks, got %d",
+	},
+	MsgBatchNoCodes: {
+		ZhCN: "codes 不能为空",
+		ZhTW: "codes 不能為空",
+		En:   "codes must not be empty",
+	},
+	MsgBatchTooMany: {
+		ZhCN: "一次最多预测 %d 只股票",
+		ZhTW: "一次最多預測 %d 隻股票",
+		En:   "at most %d stocks can be predicted at once",
+	},
+	MsgCodeRequired: {
+		ZhCN: "code 不能为空",
+		ZhTW: "code 不能為空",
+		En:   "code must not be empty",
+	},
+	MsgDaysOutOfRange: {
+		ZhCN: "预测周期须为 1～%d 个交易日（当前 %d）",
+		ZhTW: "預測週期須為 1～%d 個交易日（目前 %d）",
+		En:   "days must be between 1 and %d trading days (got %d)",
+	},
+	MsgContentRequired: {
+		ZhCN: "消息内容不能为空",
+		ZhTW: "訊息內容不能為空",
+		En:   "message content must not be empty",
+	},
+	MsgChatNeedsCode: {
+		ZhCN: "code 与 prediction_id 不能同时为空",
+		ZhTW: "code 與 prediction_id 不能同時為空",
+		En:   "either code or prediction_id is required",
+	},
+	MsgChatNotFound: {
+		ZhCN: "会话不存在",
+		ZhTW: "對話不存在",
+		En:   "chat session not found",
+	},
+	MsgPredictionNotFound: {
+		ZhCN: "预测记录不存在: %s",
+		ZhTW: "預測記錄不存在: %s",
+		En:   "prediction not found: %s",
+	},
+	MsgJobNotFound: {
+		ZhCN: "任务不存在",
+		ZhTW: "任務不存在",
+		En:   "job not found",
+	},
+	MsgJobQueueFull: {
+		ZhCN: "任务队列已满，请稍后再试",
+		ZhTW: "任務佇列已滿，請稍後再試",
+		En:   "the job queue is full; please try again later",
+	},
+	MsgJobCanceled: {
+		ZhCN: "任务已取消",
+		ZhTW: "任務已取消",
+		En:   "the job was canceled",
+	},
+	MsgCompareQuant: {
+		ZhCN: "规则量化模型不支持多股对比，请选择 LLM 模型",
+		ZhTW: "規則量化模型不支援多股對比，請選擇 LLM 模型",
+		En:   "the rule-based quant model cannot compare stocks; choose an LLM model",
+	},
+	MsgDataQuoteFailed: {
+		ZhCN: "无法获取 %s 的实时行情，已停止预测: %s",
+		ZhTW: "無法取得 %s 的即時行情，已停止預測: %s",
+		En:   "could not load the real-time quote for %s, prediction aborted: %s",
+	},
+	MsgDataMarketFailed: {
+		ZhCN: "大盘指数获取失败，分析未参考大盘走势: %s",
+		ZhTW: "大盤指數取得失敗，分析未參考大盤走勢: %s",
+		En:   "market indices failed to load, the analysis does not reflect the broader market: %s",
+	},
+	MsgDataKlineFailed: {
+		ZhCN: "日 K 获取失败，分析缺少技术指标与统计区间: %s",
+		ZhTW: "日 K 取得失敗，分析缺少技術指標與統計區間: %s",
+		En:   "daily K-line data failed to load, the analysis lacks technical indicators and statistical bands: %s",
+	},
+	MsgDirBullish: {ZhCN: "看多", ZhTW: "看多", En: "bullish"},
+	MsgDirBearish: {ZhCN: "看空", ZhTW: "看空", En: "bearish"},
+	MsgDirNeutral: {ZhCN: "震荡", ZhTW: "震盪", En: "neutral"},
+	MsgFullStop:   {ZhCN: "。", ZhTW: "。", En: "."},
+	MsgNamedItem:  {ZhCN: "- **%s**：%s\n", ZhTW: "- **%s**：%s\n", En: "- **%s**: %s\n"},
+	MsgVerdictSummary: {
+		ZhCN: "%s · 置信度 %.2f · %s",
+		ZhTW: "%s · 信心度 %.2f · %s",
+		En:   "%s · confidence %.2f · %s",
+	},
+	MsgDigestTitle: {
+		ZhCN: "# 自选股预测摘要（%s，未来 %d 个交易日）\n\n",
+		ZhTW: "# 自選股預測摘要（%s，未來 %d 個交易日）\n\n",
+		En:   "# Watchlist prediction digest (%s, next %d trading days)\n\n",
+	},
+	MsgDigestCounts: {
+		ZhCN: "共 %d 只：看多 %d、看空 %d、震荡 %d",
+		ZhTW: "共 %d 隻：看多 %d、看空 %d、震盪 %d",
+		En:   "%d stocks: %d bullish, %d bearish, %d neutral",
+	},
+	MsgDigestUnratedN: {ZhCN: "、无结构化结论 %d", ZhTW: "、無結構化結論 %d", En: ", %d without a structured verdict"},
+	MsgDigestFailedN:  {ZhCN: "、失败 %d", ZhTW: "、失敗 %d", En: ", %d failed"},
+	MsgDigestBullish:  {ZhCN: "最强看多", ZhTW: "最強看多", En: "Most bullish"},
+	MsgDigestBearish:  {ZhCN: "最强看空", ZhTW: "最強看空", En: "Most bearish"},
+	MsgDigestTop:      {ZhCN: "最高置信度", ZhTW: "最高信心度", En: "Highest confidence"},
+	MsgDigestNeutral:  {ZhCN: "震荡", ZhTW: "震盪", En: "Neutral"},
+	MsgDigestUnrated:  {ZhCN: "无结构化结论", ZhTW: "無結構化結論", En: "No structured verdict"},
+	MsgDigestRisks:    {ZhCN: "风险提示", ZhTW: "風險提示", En: "Risks"},
+	MsgDigestFailed:   {ZhCN: "预测失败", ZhTW: "預測失敗", En: "Failed"},
+	MsgRiskKeyword:    {ZhCN: "风险", ZhTW: "風險", En: "risk"},
+	MsgRiskBearish:    {ZhCN: "高置信度看空（%.2f）", ZhTW: "高信心度看空（%.2f）", En: "high-confidence bearish call (%.2f)"},
+	MsgRiskWideRange: {
+		ZhCN: "预计区间较宽（%+.1f%%～%+.1f%%），不确定性高",
+		ZhTW: "預計區間較寬（%+.1f%%～%+.1f%%），不確定性高",
+		En:   "wide expected range (%+.1f%% to %+.1f%%), high uncertainty",
+	},
+	MsgRiskOverbought: {ZhCN: "RSI6 %.1f，短线超买", ZhTW: "RSI6 %.1f，短線超買", En: "RSI6 %.1f, short-term overbought"},
+	MsgRiskOversold:   {ZhCN: "RSI6 %.1f，短线超卖", ZhTW: "RSI6 %.1f，短線超賣", En: "RSI6 %.1f, short-term oversold"},
+	MsgRiskVolume:     {ZhCN: "量比 %.1f，成交异常放大", ZhTW: "量比 %.1f，成交異常放大", En: "volume ratio %.1f, unusually heavy trading"},
+	MsgEnsembleMembers: {ZhCN: "## 各模型结论\n\n", ZhTW: "## 各模型結論\n\n", En: "## Model verdicts\n\n"},
+	MsgEnsembleMember:  {ZhCN: "- **%s**（权重 %.2f）：", ZhTW: "- **%s**（權重 %.2f）：", En: "- **%s** (weight %.2f): "},
+	MsgEnsembleMemberAcc: {
+		ZhCN: "- **%s**（权重 %.2f，历史命中率 %.0f%%／%d 次）：",
+		ZhTW: "- **%s**（權重 %.2f，歷史命中率 %.0f%%／%d 次）：",
+		En:   "- **%s** (weight %.2f, historical hit rate %.0f%% over %d predictions): ",
+	},
+	MsgEnsembleFailed:    {ZhCN: "预测失败（%s）", ZhTW: "預測失敗（%s）", En: "prediction failed (%s)"},
+	MsgEnsembleNoVerdict: {ZhCN: "未给出结构化结论", ZhTW: "未給出結構化結論", En: "no structured verdict"},
+	MsgEnsembleSummary:   {ZhCN: "\n## 综合结论\n\n", ZhTW: "\n## 綜合結論\n\n", En: "\n## Combined verdict\n\n"},
+	MsgEnsembleVotes: {
+		ZhCN: "按历史命中率加权投票：看多 %.2f、看空 %.2f、震荡 %.2f，结论 **%s**（一致度 %.0f%%，置信度 %.2f）。",
+		ZhTW: "按歷史命中率加權投票：看多 %.2f、看空 %.2f、震盪 %.2f，結論 **%s**（一致度 %.0f%%，信心度 %.2f）。",
+		En:   "Votes weighted by historical hit rate: bullish %.2f, bearish %.2f, neutral %.2f. Verdict: **%s** (agreement %.0f%%, confidence %.2f).",
+	},
+	MsgEnsembleChange: {ZhCN: "预计涨跌幅 %s。", ZhTW: "預計漲跌幅 %s。", En: " Expected change %s."},
+	MsgEnsemblePrice: {
+		ZhCN: "预计涨跌幅 %s，价格区间 %.3f～%.3f。",
+		ZhTW: "預計漲跌幅 %s，價格區間 %.3f～%.3f。",
+		En:   " Expected change %s, price range %.3f to %.3f.",
+	},
+	MsgEnsembleSplit: {
+		ZhCN: "\n\n各模型分歧较大，结论仅供参考。",
+		ZhTW: "\n\n各模型分歧較大，結論僅供參考。",
+		En:   "\n\nThe models disagree widely; treat the verdict with caution.",
+	},
+	MsgVerifyPrice: {
+		ZhCN: "回答中的现价 %s 与行情 %.3f 不符",
+		ZhTW: "回答中的現價 %s 與行情 %.3f 不符",
+		En:   "the price %s cited in the answer does not match the quote %.3f",
+	},
+	MsgVerifyChange: {
+		ZhCN: "回答中的当日%s %s%% 与行情 %.2f%% 不符",
+		ZhTW: "回答中的當日%s %s%% 與行情 %.2f%% 不符",
+		En:   "the day's %s of %s%% cited in the answer does not match the quote %.2f%%",
+	},
+	MsgVerifyVolume: {
+		ZhCN: "回答中的成交量 %s 与行情 %d 不符",
+		ZhTW: "回答中的成交量 %s 與行情 %d 不符",
+		En:   "the volume %s cited in the answer does not match the quote %d",
+	},
+	MsgVerifyIndex: {
+		ZhCN: "回答中的恒指点位 %s 与大盘数据 %.2f 不符",
+		ZhTW: "回答中的恒指點位 %s 與大盤數據 %.2f 不符",
+		En:   "the Hang Seng Index level %s cited in the answer does not match the market data %.2f",
+	},
+	MsgVerifyUnsupported: {
+		ZhCN: "回答中的价格 %s 偏离现价 %.3f 且输入数据中无此数值",
+		ZhTW: "回答中的價格 %s 偏離現價 %.3f 且輸入數據中無此數值",
+		En:   "the price %s in the answer is far from the current price %.3f and does not appear in the input data",
+	},
+	MsgRangeChangeOrder: {
+		ZhCN: "预计涨跌幅下限 %+.2f%% 高于上限 %+.2f%%",
+		ZhTW: "預計漲跌幅下限 %+.2f%% 高於上限 %+.2f%%",
+		En:   "the expected change lower bound %+.2f%% is above the upper bound %+.2f%%",
+	},
+	MsgRangePriceOrder: {
+		ZhCN: "预计价格下限 %.3f 高于上限 %.3f",
+		ZhTW: "預計價格下限 %.3f 高於上限 %.3f",
+		En:   "the expected price lower bound %.3f is above the upper bound %.3f",
+	},
+	MsgRangeBullish: {
+		ZhCN: "结论看多但预计涨跌幅上限为 %+.2f%%",
+		ZhTW: "結論看多但預計漲跌幅上限為 %+.2f%%",
+		En:   "the verdict is bullish but the expected change upper bound is %+.2f%%",
+	},
+	MsgRangeBearish: {
+		ZhCN: "结论看空但预计涨跌幅下限为 %+.2f%%",
+		ZhTW: "結論看空但預計漲跌幅下限為 %+.2f%%",
+		En:   "the verdict is bearish but the expected change lower bound is %+.2f%%",
+	},
+	MsgRangeTooWide: {
+		ZhCN: "预计涨跌幅 %+.2f%%～%+.2f%% 超出 ±%.0f%%，不合常理",
+		ZhTW: "預計漲跌幅 %+.2f%%～%+.2f%% 超出 ±%.0f%%，不合常理",
+		En:   "the expected change %+.2f%% to %+.2f%% exceeds ±%.0f%%, which is implausible",
+	},
+	MsgRangeMismatch: {
+		ZhCN: "预计价格区间 %.3f～%.3f 按现价 %.3f 折合 %+.2f%%～%+.2f%%，与给出的涨跌幅 %+.2f%%～%+.2f%% 不一致",
+		ZhTW: "預計價格區間 %.3f～%.3f 按現價 %.3f 折合 %+.2f%%～%+.2f%%，與給出的漲跌幅 %+.2f%%～%+.2f%% 不一致",
+		En:   "the expected price range %.3f to %.3f at the current price %.3f implies %+.2f%% to %+.2f%%, which does not match the stated change %+.2f%% to %+.2f%%",
+	},
+	MsgRangeBeyondBands: {
+		ZhCN: "预计价格区间 %.3f～%.3f 远超统计区间（第 %d 日 P5～P95：%.3f～%.3f）",
+		ZhTW: "預計價格區間 %.3f～%.3f 遠超統計區間（第 %d 日 P5～P95：%.3f～%.3f）",
+		En:   "the expected price range %.3f to %.3f is far outside the statistical band (day %d P5 to P95: %.3f to %.3f)",
+	},
+}
@@ -0,0 +1,425 @@
+// Package jobs 异步预测任务：提交后立即返回任务 ID，由固定数量的 worker 执行，可查询进度、取消或接入 SSE 事件流。
+// 任务持久化在数据目录 jobs/<id>.json，重启时未完成的任务重新排队；已结束的任务按保留时长与数量上限清理。
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/metrics"
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
+)
+
+// 任务状态
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCanceled  = "canceled"
+)
+
+// 进度阶段
+const (
+	StageQueued    = "queued"
+	StageGathering = "gathering" // 采集行情与技术面
+	StageTool      = "tool"      // 模型调用数据工具
+	StageReasoning = "reasoning" // 模型思考
+	StageWriting   = "writing"   // 模型输出结论
+	StageDone      = "done"
+)
+
+var (
+	// ErrNotFound 任务不存在
+	ErrNotFound error = i18n.Errorf(i18n.MsgJobNotFound)
+	// ErrQueueFull 排队任务已达上限
+	ErrQueueFull error = i18n.Errorf(i18n.MsgJobQueueFull)
+)
+
+func init() {
+	metrics.Describe("ai_jobs_total", "异步任务结束数，按 succeeded/failed/canceled 统计")
+}
+
+// Job 一个异步预测任务
+type Job struct {
+	ID         string            `json:"id"`
+	Status     string            `json:"status"`
+	Stage      string            `json:"stage"`
+	Progress   int               `json:"progress"` // 0～100，按阶段粗略估计
+	Request    predictor.Request `json:"request"`
+	Result     *predictor.Result `json:"result,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	StartedAt  time.Time         `json:"started_at,omitempty"`
+	FinishedAt time.Time         `json:"finished_at,omitempty"`
+
+	QueuePosition int `json:"queue_position,omitempty"` // 排队中时的位置（从 1 开始），查询时计算、不落盘
+}
+
+// Finished 是否已结束（成功、失败或取消）
+func (j *Job) Finished() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed || j.Status == StatusCanceled
+}
+
+// idRe 任务 ID 只允许 storage.NewID 生成的字符
+var idRe = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// Manager 任务管理：内存中保存全部任务，状态变化时落盘
+type Manager struct {
+	p           *predictor.Predictor
+	maxQueue    int
+	retention   time.Duration // 已结束任务的保留时长
+	maxFinished int           // 最多保留的已结束任务数
+
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	pending []string                      // 排队中的任务 ID，按提交顺序
+	cancels map[string]context.CancelFunc // 运行中任务的取消函数
+	feeds   map[string]*predictor.Feed    // 运行中任务的事件流
+	signal  chan struct{}
+}
+
+// NewManager 创建任务管理器并启动 worker：AI_JOB_WORKERS 并发数（默认 2），AI_JOB_QUEUE 最多排队数（默认 100）；
+// 已结束的任务保留 AI_JOB_RETENTION_HOURS 小时（默认 72），最多 AI_JOB_MAX_FINISHED 个（默认 1000）。
+// 会加载已持久化的任务，未完成的重新排队。
+func NewManager(p *predictor.Predictor) *Manager {
+	workers := envInt("AI_JOB_WORKERS", 2)
+	m := &Manager{
+		p:           p,
+		maxQueue:    envInt("AI_JOB_QUEUE", 100),
+		retention:   time.Duration(envInt("AI_JOB_RETENTION_HOURS", 72)) * time.Hour,
+		maxFinished: envInt("AI_JOB_MAX_FINISHED", 1000),
+		jobs:        map[string]*Job{},
+		cancels:     map[string]context.CancelFunc{},
+		feeds:       map[string]*predictor.Feed{},
+	}
+	m.load()
+	m.prune()
+	m.signal = make(chan struct{}, m.maxQueue+len(m.pending))
+	for range m.pending {
+		m.signal <- struct{}{}
+	}
+	for i := 0; i < workers; i++ {
+		go m.worker()
+	}
+	return m
+}
+
+func envInt(key string, def int) int {
+	if s := strings.TrimSpace(os.Getenv(key)); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n > 0 {
+			return n
+		}
+	}
+	return def
+}
+
+func jobPath(id string) string { return filepath.Join(storage.Dir("jobs"), id+".json") }
+
+// load 读取已持久化的任务；排队中或运行中（上次退出时被中断）的任务重新排队
+func (m *Manager) load() {
+	entries, err := os.ReadDir(storage.Dir("jobs"))
+	if err != nil {
+		return
+	}
+	var requeue []*Job
+	for _, e := range entries {
+		id := strings.TrimSuffix(e.Name(), ".json")
+		if e.IsDir() || id == e.Name() || !idRe.MatchString(id) {
+			continue
+		}
+		var j Job
+		if err := storage.ReadJSON(jobPath(id), &j); err != nil {
+			log.Printf("[jobs] load %s: %v", e.Name(), err)
+			continue
+		}
+		m.jobs[j.ID] = &j
+		if !j.Finished() {
+			j.Status, j.Stage, j.Progress, j.StartedAt = StatusQueued, StageQueued, 0, time.Time{}
+			requeue = append(requeue, &j)
+		}
+	}
+	sort.Slice(requeue, func(a, b int) bool { return requeue[a].CreatedAt.Before(requeue[b].CreatedAt) })
+	for _, j := range requeue {
+		m.pending = append(m.pending, j.ID)
+		m.save(j)
+	}
+	if len(requeue) > 0 {
+		log.Printf("[jobs] requeued %d unfinished jobs", len(requeue))
+	}
+}
+
+// prune 清理超过保留时长或数量上限（先删最早结束的）的已结束任务，内存与磁盘一并删除；调用方持有 m.mu 或尚未启动 worker
+func (m *Manager) prune() {
+	var done []*Job
+	for _, j := range m.jobs {
+		if j.Finished() {
+			done = append(done, j)
+		}
+	}
+	sort.Slice(done, func(a, b int) bool { return done[a].FinishedAt.After(done[b].FinishedAt) })
+	cutoff := time.Now().Add(-m.retention)
+	removed := 0
+	for i, j := range done {
+		if i < m.maxFinished && j.FinishedAt.After(cutoff) {
+			continue
+		}
+		delete(m.jobs, j.ID)
+		if err := os.Remove(jobPath(j.ID)); err != nil && !os.IsNotExist(err) {
+			log.Printf("[jobs] remove %s: %v", j.ID, err)
+		}
+		removed++
+	}
+	if removed > 0 {
+		log.Printf("[jobs] pruned %d finished jobs", removed)
+	}
+}
+
+// save 落盘，调用方持有 m.mu 或任务尚未共享
+func (m *Manager) save(j *Job) {
+	if err := storage.WriteJSON(jobPath(j.ID), j); err != nil {
+		log.Printf("[jobs] save %s: %v", j.ID, err)
+	}
+}
+
+// Submit 提交预测任务
+func (m *Manager) Submit(req predictor.Request) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.pending) >= m.maxQueue {
+		return nil, ErrQueueFull
+	}
+	j := &Job{ID: storage.NewID(), Status: StatusQueued, Stage: StageQueued, Request: req, CreatedAt: time.Now()}
+	m.jobs[j.ID] = j
+	m.pending = append(m.pending, j.ID)
+	m.save(j)
+	m.wake()
+	log.Printf("[jobs] submitted %s code=%s mode=%s", j.ID, req.Code, req.Mode)
+	return m.snapshot(j), nil
+}
+
+// Get 查询任务（返回副本）
+func (m *Manager) Get(id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return m.snapshot(j), nil
+}
+
+// snapshot 任务副本并计算排队位置，调用方持有 m.mu
+func (m *Manager) snapshot(j *Job) *Job {
+	cp := *j
+	if j.Status == StatusQueued {
+		for i, id := range m.pending {
+			if id == j.ID {
+				cp.QueuePosition = i + 1
+			}
+		}
+	}
+	return &cp
+}
+
+// Cancel 取消任务：排队中的直接标记取消，运行中的中止其 LLM 调用；已结束的任务原样返回。
+func (m *Manager) Cancel(id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	switch j.Status {
+	case StatusQueued:
+		for i, pid := range m.pending {
+			if pid == id {
+				m.pending = append(m.pending[:i], m.pending[i+1:]...)
+				break
+			}
+		}
+		m.finish(j, StatusCanceled, nil, "")
+	case StatusRunning:
+		if cancel := m.cancels[id]; cancel != nil {
+			cancel()
+		}
+	}
+	return m.snapshot(j), nil
+}
+
+// finish 记录任务结束，调用方持有 m.mu
+func (m *Manager) finish(j *Job, status string, res *predictor.Result, errMsg string) {
+	j.Status, j.Result, j.Error, j.FinishedAt = status, res, errMsg, time.Now()
+	if status == StatusSucceeded {
+		j.Stage, j.Progress = StageDone, 100
+	}
+	m.save(j)
+	metrics.Inc("ai_jobs_total", "status", status)
+	if f := m.feeds[j.ID]; f != nil {
+		f.Finish()
+		delete(m.feeds, j.ID)
+	}
+	delete(m.cancels, j.ID)
+	m.prune()
+}
+
+// wake 通知 worker 有新任务；通道已满说明待处理的通知已不少于排队数，无需再发
+func (m *Manager) wake() {
+	select {
+	case m.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) worker() {
+	for range m.signal {
+		m.mu.Lock()
+		if len(m.pending) == 0 {
+			m.mu.Unlock()
+			continue
+		}
+		id := m.pending[0]
+		m.pending = m.pending[1:]
+		j := m.jobs[id]
+		ctx, cancel := context.WithCancel(usage.WithEndpoint(context.Background(), "jobs"))
+		j.Status, j.Stage, j.Progress, j.StartedAt = StatusRunning, StageGathering, 5, time.Now()
+		m.cancels[id] = cancel
+		f := predictor.NewFeed()
+		m.feeds[id] = f
+		m.save(j)
+		req := j.Request
+		m.mu.Unlock()
+
+		res, err := m.p.StreamPredict(ctx, req, func(ev predictor.Event) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			m.progress(j, ev)
+			return f.Add(ev)
+		})
+
+		m.mu.Lock()
+		switch {
+		case ctx.Err() != nil:
+			m.finish(j, StatusCanceled, nil, "")
+		case err != nil:
+			m.finish(j, StatusFailed, nil, i18n.Localize(err, req.Language))
+		default:
+			m.finish(j, StatusSucceeded, res, "")
+		}
+		log.Printf("[jobs] %s %s", id, j.Status)
+		m.mu.Unlock()
+		cancel()
+	}
+}
+
+// progress 由流式事件推进阶段与进度（仅为粗略估计）
+func (m *Manager) progress(j *Job, ev predictor.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stage, pct := j.Stage, j.Progress
+	switch {
+	case ev.Type == predictor.EventContext, ev.Type == predictor.EventTechnical, ev.Type == predictor.EventBands:
+		stage, pct = StageGathering, 20
+	case ev.Type == predictor.EventTool:
+		stage, pct = StageTool, 30
+	case strings.HasSuffix(ev.Type, predictor.EventReasoning):
+		stage, pct = StageReasoning, 40
+	case strings.HasSuffix(ev.Type, predictor.EventContent):
+		stage = StageWriting
+		if pct < 60 {
+			pct = 60
+		} else if pct < 95 && len(ev.Text) > 0 {
+			pct++ // 每段增量推进 1%，结束前不超过 95
+		}
+	}
+	if pct < j.Progress {
+		pct = j.Progress
+	}
+	j.Stage, j.Progress = stage, pct
+}
+
+// Attach 接入任务事件流：运行中的任务先重放已产生的事件再持续推送，已结束的任务重放结果（content + result）。
+// 任务失败时返回其错误，取消时返回 context.Canceled。
+func (m *Manager) Attach(ctx context.Context, id string, fn predictor.EventFunc) error {
+	m.mu.Lock()
+	j, ok := m.jobs[id]
+	if !ok {
+		m.mu.Unlock()
+		return ErrNotFound
+	}
+	f := m.feeds[id]
+	m.mu.Unlock()
+
+	if f != nil {
+		for i := 0; ; {
+			evs, done, wait := f.Read(i)
+			for _, ev := range evs {
+				if err := fn(ev); err != nil {
+					return err
+				}
+			}
+			i += len(evs)
+			if done {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-wait:
+			}
+		}
+	} else {
+		// 排队中：等待开始
+		for {
+			cur, err := m.Get(id)
+			if err != nil {
+				return err
+			}
+			if cur.Status != StatusQueued {
+				if cur.Status == StatusRunning {
+					return m.Attach(ctx, id, fn)
+				}
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(500 * time.Millisecond):
+			}
+		}
+		cur, _ := m.Get(id)
+		if cur.Status == StatusSucceeded && cur.Result != nil {
+			if err := fn(predictor.Event{Type: predictor.EventContent, Text: cur.Result.Analysis}); err != nil {
+				return err
+			}
+			if err := fn(predictor.Event{Type: predictor.EventResult, Data: cur.Result}); err != nil {
+				return err
+			}
+		}
+		j = cur
+	}
+
+	m.mu.Lock()
+	status, errMsg := j.Status, j.Error
+	m.mu.Unlock()
+	switch status {
+	case StatusFailed:
+		return errors.New(errMsg)
+	case StatusCanceled:
+		return context.Canceled
+	}
+	return nil
+}
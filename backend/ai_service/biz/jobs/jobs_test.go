@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPrune(t *testing.T) {
+	t.Setenv("AI_DATA_DIR", t.TempDir())
+	m := &Manager{retention: time.Hour, maxFinished: 2, jobs: map[string]*Job{}}
+	now := time.Now()
+	for _, j := range []*Job{
+		{ID: "old", Status: StatusSucceeded, FinishedAt: now.Add(-2 * time.Hour)},
+		{ID: "a", Status: StatusFailed, FinishedAt: now.Add(-3 * time.Minute)},
+		{ID: "b", Status: StatusCanceled, FinishedAt: now.Add(-2 * time.Minute)},
+		{ID: "c", Status: StatusSucceeded, FinishedAt: now.Add(-time.Minute)},
+		{ID: "queued", Status: StatusQueued},
+	} {
+		m.jobs[j.ID] = j
+		m.save(j)
+	}
+	m.prune()
+	for id, keep := range map[string]bool{"old": false, "a": false, "b": true, "c": true, "queued": true} {
+		if _, ok := m.jobs[id]; ok != keep {
+			t.Errorf("job %s in memory = %v, want %v", id, ok, keep)
+		}
+		if _, err := os.Stat(jobPath(id)); (err == nil) != keep {
+			t.Errorf("job %s on disk = %v, want %v", id, err == nil, keep)
+		}
+	}
+}
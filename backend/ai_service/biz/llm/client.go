@@ -0,0 +1,544 @@
+// Package llm OpenAI 兼容 /chat/completions 客户端（智谱、OpenAI 等），流式与非流式共用同一请求构建与响应解析。
+package llm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/metrics"
+)
+
+const (
+	zhipuBaseURL = "https://open.bigmodel.cn/api/paas/v4"
+	zhipuModel   = "glm-4-flash"
+
+	// defaultMaxTokens 推理模型（如 GLM-5）需更多 token，避免 finish_reason=length 时仅 reasoning_content 有内容
+	defaultMaxTokens = 4000
+)
+
+// 智谱推理模型（如 GLM-5）响应较慢，默认 120s；可通过环境变量 LLM_TIMEOUT_SEC 覆盖（单位：秒）
+var (
+	httpClientOnce    sync.Once
+	defaultHTTPClient *http.Client
+)
+
+// TimeoutSec 单次 LLM 调用超时（秒），LLM_TIMEOUT_SEC 覆盖默认 120。
+func TimeoutSec() int {
+	sec := 120
+	if s := os.Getenv("LLM_TIMEOUT_SEC"); s != "" {
+		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n > 0 {
+			sec = n
+		}
+	}
+	return sec
+}
+
+func getHTTPClient() *http.Client {
+	httpClientOnce.Do(func() {
+		defaultHTTPClient = &http.Client{Timeout: time.Duration(TimeoutSec()) * time.Second}
+	})
+	return defaultHTTPClient
+}
+
+// Message 对话消息；assistant 发起工具调用时带 ToolCalls，工具结果以 role=tool + ToolCallID 回传
+type Message struct {
+	Role       string     `json:"role"`
+	Content    string     `json:"content"`
+	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string     `json:"tool_call_id,omitempty"`
+}
+
+// Tool 可供模型调用的函数（OpenAI tools 格式），Parameters 为 JSON Schema
+type Tool struct {
+	Type     string      `json:"type"` // 固定 function
+	Function FunctionDef `json:"function"`
+}
+
+// FunctionDef 函数声明
+type FunctionDef struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Parameters  interface{} `json:"parameters"`
+}
+
+// ToolCall 模型发起的一次函数调用，Arguments 为 JSON 字符串
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     string       `json:"type"`
+	Function FunctionCall `json:"function"`
+}
+
+// FunctionCall 调用的函数名与参数
+type FunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// Request 一次 chat/completions 调用
+type Request struct {
+	Model      string
+	Messages   []Message
+	MaxTokens  int      // 0 为默认 4000
+	Tools      []Tool   // 为空时不带 tools 参数
+	ToolChoice string   // auto（默认）或 none（禁止继续调用工具）
+	OnWait     WaitFunc // 排队、限速或重试等待时回调，可为 nil
+}
+
+// Response 调用结果；流式时为各 delta 累积后的结果
+type Response struct {
+	Model        string
+	Content      string // 最终回答
+	Reasoning    string // 推理模型的思考过程（reasoning_content）
+	ToolCalls    []ToolCall
+	FinishReason string
+	Usage        Usage // 服务商返回的 token 用量，未返回时为零值
+}
+
+// Usage token 用量；ReasoningTokens 为 CompletionTokens 中的推理部分（服务商给出时）
+type Usage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+	ReasoningTokens  int64 `json:"reasoning_tokens,omitempty"`
+	TotalTokens      int64 `json:"total_tokens"`
+}
+
+// usageJSON 响应中的 usage 字段（非流式与流式末尾分片相同）
+type usageJSON struct {
+	PromptTokens            int64 `json:"prompt_tokens"`
+	CompletionTokens        int64 `json:"completion_tokens"`
+	TotalTokens             int64 `json:"total_tokens"`
+	CompletionTokensDetails *struct {
+		ReasoningTokens int64 `json:"reasoning_tokens"`
+	} `json:"completion_tokens_details"`
+}
+
+func (u *usageJSON) toUsage() Usage {
+	if u == nil {
+		return Usage{}
+	}
+	out := Usage{PromptTokens: u.PromptTokens, CompletionTokens: u.CompletionTokens, TotalTokens: u.TotalTokens}
+	if d := u.CompletionTokensDetails; d != nil {
+		out.ReasoningTokens = d.ReasoningTokens
+	}
+	if out.TotalTokens == 0 {
+		out.TotalTokens = out.PromptTokens + out.CompletionTokens
+	}
+	return out
+}
+
+// StatusError 接口返回非 200
+type StatusError struct {
+	Code       int
+	Body       string
+	RetryAfter time.Duration // 响应头 Retry-After，未给出时为 0
+}
+
+func (e *StatusError) Error() string { return fmt.Sprintf("LLM 返回 %d: %s", e.Code, e.Body) }
+
+// Delta 流式增量的类型
+const (
+	DeltaReasoning = "reasoning"
+	DeltaContent   = "content"
+)
+
+// DeltaFunc 流式回调，kind 为 DeltaReasoning 或 DeltaContent
+type DeltaFunc func(kind, text string) error
+
+// Client OpenAI 兼容接口客户端：默认服务商之外，可按模型名路由到 LLM_PROVIDERS 中的其他服务商
+type Client struct {
+	apiKey  string
+	baseURL string
+	model   string
+	limiter *limiter
+	routes  map[string]*Client // 模型名 → 额外服务商
+}
+
+// providerConfig LLM_PROVIDERS 中的一个服务商
+type providerConfig struct {
+	BaseURL string   `json:"base_url"`
+	APIKey  string   `json:"api_key"`
+	Models  []string `json:"models"`
+}
+
+// NewFromEnv 优先 ZHIPU_API_KEY（智谱），否则 LLM_API_KEY + LLM_BASE_URL + LLM_MODEL（OpenAI 兼容）。
+// LLM_PROVIDERS 可另配其他 OpenAI 兼容服务商（JSON，名称 → {"base_url","api_key","models"}），
+// models 中的模型改由该服务商调用，用于同时使用智谱与 OpenAI 等多家模型。
+func NewFromEnv() *Client {
+	apiKey := os.Getenv("ZHIPU_API_KEY")
+	baseURL := zhipuBaseURL
+	model := os.Getenv("ZHIPU_MODEL")
+	if model == "" {
+		model = zhipuModel
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("LLM_API_KEY")
+		baseURL = os.Getenv("LLM_BASE_URL")
+		if baseURL == "" {
+			baseURL = "https://api.openai.com/v1"
+		}
+		model = os.Getenv("LLM_MODEL")
+		if model == "" {
+			model = "gpt-4o-mini"
+		}
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+	c := &Client{apiKey: apiKey, baseURL: baseURL, model: model, limiter: limiterFor(baseURL), routes: map[string]*Client{}}
+	if s := strings.TrimSpace(os.Getenv("LLM_PROVIDERS")); s != "" {
+		var providers map[string]providerConfig
+		if err := json.Unmarshal([]byte(s), &providers); err != nil {
+			log.Printf("[llm] LLM_PROVIDERS 解析失败，忽略: %v", err)
+		}
+		for name, pc := range providers {
+			if pc.BaseURL == "" || pc.APIKey == "" {
+				log.Printf("[llm] LLM_PROVIDERS 中 %s 缺少 base_url 或 api_key，忽略", name)
+				continue
+			}
+			u := strings.TrimRight(pc.BaseURL, "/")
+			p := &Client{apiKey: pc.APIKey, baseURL: u, model: c.model, limiter: limiterFor(u)}
+			for _, m := range pc.Models {
+				c.routes[m] = p
+			}
+		}
+	}
+	return c
+}
+
+// provider 调用 model 的服务商
+func (c *Client) provider(model string) *Client {
+	if p, ok := c.routes[model]; ok {
+		return p
+	}
+	return c
+}
+
+// Configured 是否配置了默认服务商的 API Key
+func (c *Client) Configured() bool { return c.apiKey != "" }
+
+// DefaultModel 未指定 model 时使用的模型
+func (c *Client) DefaultModel() string { return c.model }
+
+func init() {
+	metrics.Describe("llm_calls_total", "LLM 调用次数，按结果 completed/canceled/failed 统计")
+	metrics.Describe("llm_retries_total", "LLM 调用重试次数，按触发原因统计")
+}
+
+// Complete 调用 LLM。onDelta 为 nil 时走非流式，否则以 stream=true 调用并逐段回调，两种模式返回相同结构的 Response。
+// 同一服务商的调用共享并发与速率限制；429、5xx 与网络错误在收到响应正文前按退避重试（LLM_MAX_RETRIES，默认 3 次）。
+// ctx 取消时（如客户端断开）中止排队与进行中的请求。
+// 出错时 Response 可能非 nil：请求已发出后被取消或流式输出中断，此时为已收到的部分内容（通常无 Usage），供调用方估算用量。
+func (c *Client) Complete(ctx context.Context, req Request, onDelta DeltaFunc) (*Response, error) {
+	resp, err := c.complete(ctx, req, onDelta)
+	switch {
+	case err == nil:
+		metrics.Inc("llm_calls_total", "outcome", "completed")
+	case ctx.Err() != nil:
+		metrics.Inc("llm_calls_total", "outcome", "canceled")
+		log.Printf("[llm] model=%s canceled: %v", req.Model, ctx.Err())
+		return resp, ctx.Err()
+	default:
+		metrics.Inc("llm_calls_total", "outcome", "failed")
+	}
+	return resp, err
+}
+
+func (c *Client) complete(ctx context.Context, req Request, onDelta DeltaFunc) (*Response, error) {
+	if req.Model == "" {
+		req.Model = c.model
+	}
+	c = c.provider(req.Model)
+	if req.MaxTokens <= 0 {
+		req.MaxTokens = defaultMaxTokens
+	}
+	stream := onDelta != nil
+	body := map[string]interface{}{
+		"model":      req.Model,
+		"messages":   req.Messages,
+		"max_tokens": req.MaxTokens,
+	}
+	if stream {
+		body["stream"] = true
+		if streamUsage() {
+			body["stream_options"] = map[string]bool{"include_usage": true}
+		}
+	}
+	if len(req.Tools) > 0 {
+		body["tools"] = req.Tools
+		if req.ToolChoice != "" {
+			body["tool_choice"] = req.ToolChoice
+		}
+	}
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("构建请求体: %w", err)
+	}
+	maxRetries := envInt("LLM_MAX_RETRIES", 3)
+	for attempt := 0; ; attempt++ {
+		release, err := c.limiter.acquire(ctx, req.OnWait)
+		if err != nil {
+			return nil, err
+		}
+		out, err := c.send(ctx, req, bodyBytes, onDelta)
+		release()
+		if err == nil {
+			return out, nil
+		}
+		if ctx.Err() != nil {
+			return out, ctx.Err()
+		}
+		if !retryable(err) {
+			return out, err
+		}
+		var se *StatusError
+		var retryAfter time.Duration
+		if errors.As(err, &se) {
+			retryAfter = se.RetryAfter
+		}
+		if attempt >= maxRetries {
+			if se != nil && se.Code == http.StatusTooManyRequests {
+				return nil, i18n.Wrap(err, i18n.MsgRateLimited, attempt)
+			}
+			return nil, err
+		}
+		reason := "network"
+		if se != nil {
+			reason = strconv.Itoa(se.Code)
+		}
+		metrics.Inc("llm_retries_total", "reason", reason)
+		wait := backoff(attempt+1, retryAfter)
+		if se != nil && se.Code == http.StatusTooManyRequests {
+			c.limiter.pause(wait)
+		}
+		log.Printf("[llm] model=%s attempt %d failed: %v, retry in %v", req.Model, attempt+1, err, wait)
+		if req.OnWait != nil {
+			req.OnWait(WaitStatus{Reason: WaitRetry, Attempt: attempt + 1, DelayMs: wait.Milliseconds(), Error: err.Error()})
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// streamUsage 流式调用是否请求末尾的 usage 分片（stream_options.include_usage）；
+// 个别兼容接口不认该参数时设 LLM_STREAM_USAGE=0 关闭
+func streamUsage() bool {
+	return strings.TrimSpace(os.Getenv("LLM_STREAM_USAGE")) != "0"
+}
+
+// sendError 发出请求阶段（收到响应前）的网络错误，可重试
+type sendError struct{ err error }
+
+func (e *sendError) Error() string { return fmt.Sprintf("调用 LLM 失败: %v", e.err) }
+func (e *sendError) Unwrap() error { return e.err }
+
+// send 发送一次请求并解析响应；请求已发出后 ctx 取消或流式读取出错时一并返回部分响应
+func (c *Client) send(ctx context.Context, req Request, bodyBytes []byte, onDelta DeltaFunc) (*Response, error) {
+	stream := onDelta != nil
+	timeoutSec := TimeoutSec()
+	llmCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(llmCtx, "POST", c.baseURL+"/chat/completions", strings.NewReader(string(bodyBytes)))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	log.Printf("[llm] calling model=%s stream=%v tools=%d (timeout=%ds)", req.Model, stream, len(req.Tools), timeoutSec)
+	resp, err := getHTTPClient().Do(httpReq)
+	if err != nil {
+		log.Printf("[llm] request error: %v", err)
+		if ctx.Err() != nil {
+			return &Response{Model: req.Model}, ctx.Err() // 服务商可能已处理提示词
+		}
+		return nil, &sendError{err: err}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		bs, _ := io.ReadAll(resp.Body)
+		return nil, &StatusError{Code: resp.StatusCode, Body: string(bs), RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+	var out *Response
+	if stream {
+		out, err = readStream(resp.Body, onDelta)
+	} else {
+		out, err = readJSON(resp.Body)
+	}
+	if out == nil && err != nil && ctx.Err() != nil {
+		out = &Response{} // 读取响应时被取消
+	}
+	if out != nil {
+		out.Model = req.Model
+	}
+	return out, err
+}
+
+// readJSON 解析非流式响应
+func readJSON(r io.Reader) (*Response, error) {
+	respBytes, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("读取 LLM 响应: %w", err)
+	}
+	var out struct {
+		Error *struct {
+			Message string `json:"message"`
+			Code    string `json:"code"`
+		} `json:"error"`
+		Choices []struct {
+			Message struct {
+				Content          interface{} `json:"content"`           // 最终回答
+				ReasoningContent string      `json:"reasoning_content"` // 智谱推理模型（如 GLM-5）的思考过程，finish_reason=length 时可能只有此项
+				ToolCalls        []ToolCall  `json:"tool_calls"`
+			} `json:"message"`
+			FinishReason string `json:"finish_reason"`
+		} `json:"choices"`
+		Usage *usageJSON `json:"usage"`
+	}
+	if err := json.Unmarshal(respBytes, &out); err != nil {
+		return nil, fmt.Errorf("解析 LLM 响应: %w", err)
+	}
+	if out.Error != nil && out.Error.Message != "" {
+		return nil, fmt.Errorf("LLM 错误: %s", out.Error.Message)
+	}
+	if len(out.Choices) == 0 {
+		log.Printf("[llm] 响应无 choices，原始响应(前500字): %s", truncate(string(respBytes), 500))
+		return nil, fmt.Errorf("LLM 未返回内容")
+	}
+	choice := out.Choices[0]
+	return &Response{
+		Content:      contentToString(choice.Message.Content),
+		Reasoning:    choice.Message.ReasoningContent,
+		ToolCalls:    choice.Message.ToolCalls,
+		FinishReason: choice.FinishReason,
+		Usage:        out.Usage.toUsage(),
+	}, nil
+}
+
+// readStream 解析 SSE 流（data: {...} / data: [DONE]），逐段回调并累积；工具调用按 index 拼接参数片段。
+// 读取或回调出错时返回已累积的部分内容与错误。
+func readStream(r io.Reader, onDelta DeltaFunc) (*Response, error) {
+	out := &Response{}
+	var content, reasoning strings.Builder
+	var calls []*ToolCall
+	result := func() *Response {
+		out.Content = content.String()
+		out.Reasoning = reasoning.String()
+		out.ToolCalls = nil
+		for _, c := range calls {
+			if c.Function.Name != "" {
+				out.ToolCalls = append(out.ToolCalls, *c)
+			}
+		}
+		return out
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		payload := strings.TrimPrefix(line, "data: ")
+		if payload == "[DONE]" {
+			break
+		}
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content          string `json:"content"`
+					ReasoningContent string `json:"reasoning_content"` // 智谱 GLM-5 流式推理内容
+					ToolCalls        []struct {
+						Index int `json:"index"`
+						ToolCall
+					} `json:"tool_calls"`
+				} `json:"delta"`
+				FinishReason string `json:"finish_reason"`
+			} `json:"choices"`
+			Usage *usageJSON `json:"usage"` // include_usage 时末尾分片携带（choices 为空）
+		}
+		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+			continue
+		}
+		if chunk.Usage != nil {
+			out.Usage = chunk.Usage.toUsage()
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		choice := chunk.Choices[0]
+		if choice.FinishReason != "" {
+			out.FinishReason = choice.FinishReason
+		}
+		if d := choice.Delta.ReasoningContent; d != "" {
+			reasoning.WriteString(d)
+			if err := onDelta(DeltaReasoning, d); err != nil {
+				return result(), err
+			}
+		}
+		if d := choice.Delta.Content; d != "" {
+			content.WriteString(d)
+			if err := onDelta(DeltaContent, d); err != nil {
+				return result(), err
+			}
+		}
+		for _, d := range choice.Delta.ToolCalls {
+			if d.Index < 0 {
+				return result(), fmt.Errorf("解析 LLM 流式响应: tool_calls index %d 无效", d.Index)
+			}
+			for len(calls) <= d.Index {
+				calls = append(calls, &ToolCall{Type: "function"})
+			}
+			c := calls[d.Index]
+			if d.ID != "" {
+				c.ID = d.ID
+			}
+			if d.Function.Name != "" {
+				c.Function.Name = d.Function.Name
+			}
+			c.Function.Arguments += d.Function.Arguments
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return result(), err
+	}
+	return result(), nil
+}
+
+func contentToString(c interface{}) string {
+	if c == nil {
+		return ""
+	}
+	switch v := c.(type) {
+	case string:
+		return v
+	case []interface{}:
+		var b strings.Builder
+		for _, part := range v {
+			if m, ok := part.(map[string]interface{}); ok {
+				if t, ok := m["text"].(string); ok {
+					b.WriteString(t)
+				}
+			}
+		}
+		return b.String()
+	default:
+		return fmt.Sprint(c)
+	}
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
@@ -0,0 +1,261 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 等待原因
+const (
+	WaitQueue = "queue" // 并发已满，排队中
+	WaitRate  = "rate"  // 超出每分钟请求数，等待令牌
+	WaitRetry = "retry" // 429/5xx 或网络错误后退避重试
+)
+
+// WaitStatus 调用开始前的等待状态，经 Request.OnWait 回调（流式时转为 queue 事件）
+type WaitStatus struct {
+	Reason   string `json:"reason"`
+	Position int    `json:"position,omitempty"` // 排队位置（从 1 开始），仅 WaitQueue
+	Attempt  int    `json:"attempt,omitempty"`  // 第几次重试，仅 WaitRetry
+	DelayMs  int64  `json:"delay_ms,omitempty"` // 预计等待时长，WaitRate/WaitRetry
+	Error    string `json:"error,omitempty"`    // 触发重试的错误，仅 WaitRetry
+}
+
+// WaitFunc 等待状态回调
+type WaitFunc func(WaitStatus)
+
+const (
+	maxBackoff    = 20 * time.Second
+	maxRetryAfter = 60 * time.Second
+)
+
+func envInt(key string, def int) int {
+	if s := strings.TrimSpace(os.Getenv(key)); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return def
+}
+
+// limiter 同一服务商（base URL）共享的并发信号量与令牌桶。
+// 并发槽按 FIFO 分配，以便给出排队位置；令牌桶限制每分钟发出的请求数，429 时整体暂停 Retry-After。
+type limiter struct {
+	maxActive int
+	rate      float64 // 每秒令牌数，0 不限速
+	burst     float64
+
+	mu          sync.Mutex
+	active      int
+	queue       []*int // 等待者，按到达顺序；指针仅作身份标识
+	changed     chan struct{}
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = map[string]*limiter{}
+)
+
+// limiterFor 按服务商地址取共享限流器：LLM_MAX_CONCURRENCY 并发数（默认 4，0 不限），
+// LLM_RATE_PER_MIN 每分钟请求数（默认 60，0 不限），LLM_RATE_BURST 突发数（默认同并发数）。
+func limiterFor(baseURL string) *limiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	if l, ok := limiters[baseURL]; ok {
+		return l
+	}
+	maxActive := envInt("LLM_MAX_CONCURRENCY", 4)
+	burst := envInt("LLM_RATE_BURST", maxActive)
+	if burst <= 0 {
+		burst = 1
+	}
+	l := &limiter{
+		maxActive: maxActive,
+		rate:      float64(envInt("LLM_RATE_PER_MIN", 60)) / 60,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		last:      time.Now(),
+		changed:   make(chan struct{}),
+	}
+	limiters[baseURL] = l
+	return l
+}
+
+// acquire 按到达顺序等待并发槽，再等待令牌；返回释放函数。等待期间位置变化时回调 onWait。
+func (l *limiter) acquire(ctx context.Context, onWait WaitFunc) (func(), error) {
+	me := new(int)
+	l.mu.Lock()
+	l.queue = append(l.queue, me)
+	lastPos := 0
+	for {
+		pos := l.position(me)
+		if pos == 1 && (l.maxActive <= 0 || l.active < l.maxActive) {
+			l.queue = l.queue[1:]
+			l.active++
+			l.notify()
+			break
+		}
+		changed := l.changed
+		l.mu.Unlock()
+		if pos != lastPos && onWait != nil {
+			onWait(WaitStatus{Reason: WaitQueue, Position: pos}) // 回调可能写网络，不持锁
+		}
+		lastPos = pos
+		select {
+		case <-ctx.Done():
+			l.mu.Lock()
+			l.remove(me)
+			l.notify()
+			l.mu.Unlock()
+			return nil, ctx.Err()
+		case <-changed:
+		}
+		l.mu.Lock()
+	}
+	l.mu.Unlock()
+
+	release := func() {
+		l.mu.Lock()
+		l.active--
+		l.notify()
+		l.mu.Unlock()
+	}
+	if err := l.take(ctx, onWait); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// take 取一个令牌，不足或处于 429 暂停期时等待
+func (l *limiter) take(ctx context.Context, onWait WaitFunc) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		var wait time.Duration
+		if now.Before(l.pausedUntil) {
+			wait = l.pausedUntil.Sub(now)
+		} else if l.rate > 0 {
+			l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+			l.last = now
+			if l.tokens >= 1 {
+				l.tokens--
+			} else {
+				wait = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+			}
+		}
+		l.mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+		if onWait != nil {
+			onWait(WaitStatus{Reason: WaitRate, DelayMs: wait.Milliseconds()})
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// pause 收到 429 时让同一服务商的后续请求至少等待 d
+func (l *limiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// position 调用方持有 l.mu
+func (l *limiter) position(me *int) int {
+	for i, w := range l.queue {
+		if w == me {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// remove 调用方持有 l.mu
+func (l *limiter) remove(me *int) {
+	for i, w := range l.queue {
+		if w == me {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// notify 唤醒所有等待者重新检查位置，调用方持有 l.mu
+func (l *limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// retryable 429、5xx 与收到响应前的网络错误可重试；已开始读取响应正文（流式已输出）的错误不重试
+func retryable(err error) bool {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.Code == http.StatusTooManyRequests || se.Code >= 500
+	}
+	var sendErr *sendError
+	return errors.As(err, &sendErr)
+}
+
+// backoff 第 attempt 次重试的等待：指数退避（LLM_RETRY_BASE_MS，默认 1000ms）并在上限的后一半内随机抖动，
+// 服务端给出 Retry-After 时不少于该值。
+func backoff(attempt int, retryAfter time.Duration) time.Duration {
+	base := time.Duration(envInt("LLM_RETRY_BASE_MS", 1000)) * time.Millisecond
+	ceil := base << uint(attempt-1)
+	if ceil <= 0 || ceil > maxBackoff {
+		ceil = maxBackoff
+	}
+	d := time.Duration(rand.Int63n(int64(ceil)/2+1)) + ceil/2
+	if retryAfter > d {
+		d = retryAfter
+	}
+	return d
+}
+
+// parseRetryAfter 解析 Retry-After（秒数或 HTTP 日期），上限 maxRetryAfter
+func parseRetryAfter(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	var d time.Duration
+	if n, err := strconv.Atoi(v); err == nil {
+		d = time.Duration(n) * time.Second
+	} else if t, err := http.ParseTime(v); err == nil {
+		d = time.Until(t)
+	}
+	if d < 0 {
+		return 0
+	}
+	if d > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return d
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
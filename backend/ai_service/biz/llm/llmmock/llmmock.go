@@ -0,0 +1,334 @@
+// Package llmmock 本地模拟的 OpenAI 兼容 /chat/completions 服务，用于离线端到端运行与测试：
+// 支持非流式 JSON 与 SSE 流式（含 reasoning_content 增量与工具调用）、finish_reason=length、错误响应、429 与慢响应。
+// 回复按脚本依次匹配，未匹配时回显请求并附带可解析的结构化结论。
+//
+// 在 Go 测试中：
+//
+//	mock := llmmock.New(llmmock.Reply{Status: 429, Times: 1}, llmmock.Reply{Content: "……"})
+//	ts := httptest.NewServer(mock)
+//	defer ts.Close()
+//	os.Setenv("LLM_API_KEY", "mock")
+//	os.Setenv("LLM_BASE_URL", ts.URL)
+package llmmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+)
+
+// Reply 一条脚本回复。Model、Match 为空时匹配任意请求；Times 用尽后不再匹配。
+type Reply struct {
+	Model string `json:"model,omitempty"` // 仅匹配此模型
+	Match string `json:"match,omitempty"` // 仅匹配任一消息内容包含此文本的请求
+	Times int    `json:"times,omitempty"` // 最多使用次数，0 为不限
+
+	Content      string         `json:"content,omitempty"`
+	Reasoning    string         `json:"reasoning,omitempty"` // 以 reasoning_content 返回
+	ToolCalls    []llm.ToolCall `json:"tool_calls,omitempty"`
+	FinishReason string         `json:"finish_reason,omitempty"` // 默认 stop，有工具调用时 tool_calls；可设 length 模拟截断
+
+	Status     int    `json:"status,omitempty"`      // 非 0 且非 200 时返回该状态码与错误体
+	Error      string `json:"error,omitempty"`       // 错误信息；Status 为 0 时以 200 + {"error":{...}} 返回
+	RetryAfter int    `json:"retry_after,omitempty"` // 秒，写入 Retry-After 响应头
+
+	DelayMs      int `json:"delay_ms,omitempty"`       // 返回响应头前等待
+	ChunkDelayMs int `json:"chunk_delay_ms,omitempty"` // 流式每个分片之间等待，0 时取 Server.ChunkDelay
+}
+
+// Request 收到的一次请求
+type Request struct {
+	Model     string        `json:"model"`
+	Stream    bool          `json:"stream"`
+	Messages  []llm.Message `json:"messages"`
+	Tools     []string      `json:"tools,omitempty"` // 声明的工具名
+	MaxTokens int           `json:"max_tokens"`
+}
+
+// Server 模拟服务，实现 http.Handler；路径以 /chat/completions 结尾的 POST 请求均受理（兼容 /v1 等前缀）。
+type Server struct {
+	ChunkSize  int           // 流式每个分片的字符数，默认 8
+	ChunkDelay time.Duration // 流式分片间隔，默认 0
+
+	mu       sync.Mutex
+	replies  []Reply
+	used     []int
+	requests []Request
+}
+
+// New 以脚本回复创建服务，replies 为空时总是回显
+func New(replies ...Reply) *Server {
+	return &Server{ChunkSize: 8, replies: replies, used: make([]int, len(replies))}
+}
+
+// LoadScript 读取脚本文件（Reply 的 JSON 数组）
+func LoadScript(path string) ([]Reply, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取脚本: %w", err)
+	}
+	var replies []Reply
+	if err := json.Unmarshal(bs, &replies); err != nil {
+		return nil, fmt.Errorf("解析脚本: %w", err)
+	}
+	return replies, nil
+}
+
+// Requests 已收到的请求（按到达顺序）
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset 清空请求记录与脚本使用次数
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.used = make([]int, len(s.replies))
+}
+
+// chatRequest 请求体中用到的字段
+type chatRequest struct {
+	Model         string        `json:"model"`
+	Messages      []llm.Message `json:"messages"`
+	Stream        bool          `json:"stream"`
+	MaxTokens     int           `json:"max_tokens"`
+	Tools         []llm.Tool    `json:"tools"`
+	StreamOptions *struct {
+		IncludeUsage bool `json:"include_usage"`
+	} `json:"stream_options"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.URL.Path, "/chat/completions") {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req chatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "请求体不是合法 JSON: "+err.Error())
+		return
+	}
+	reply := s.record(req)
+
+	if reply.DelayMs > 0 && !sleep(r, time.Duration(reply.DelayMs)*time.Millisecond) {
+		return
+	}
+	if reply.Status != 0 && reply.Status != http.StatusOK {
+		if reply.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(reply.RetryAfter))
+		}
+		msg := reply.Error
+		if msg == "" {
+			msg = http.StatusText(reply.Status)
+		}
+		writeError(w, reply.Status, strconv.Itoa(reply.Status), msg)
+		return
+	}
+	if reply.Error != "" {
+		writeError(w, http.StatusOK, "mock_error", reply.Error)
+		return
+	}
+	if reply.FinishReason == "" {
+		reply.FinishReason = "stop"
+		if len(reply.ToolCalls) > 0 {
+			reply.FinishReason = "tool_calls"
+		}
+	}
+	usage := estimateUsage(req, reply)
+	if req.Stream {
+		s.writeStream(w, r, req, reply, usage)
+		return
+	}
+	msg := map[string]interface{}{"role": "assistant", "content": reply.Content}
+	if reply.Reasoning != "" {
+		msg["reasoning_content"] = reply.Reasoning
+	}
+	if len(reply.ToolCalls) > 0 {
+		msg["tool_calls"] = reply.ToolCalls
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":      "mock-" + strconv.FormatInt(time.Now().UnixNano(), 36),
+		"object":  "chat.completion",
+		"model":   req.Model,
+		"choices": []interface{}{map[string]interface{}{"index": 0, "message": msg, "finish_reason": reply.FinishReason}},
+		"usage":   usage,
+	})
+}
+
+// record 记录请求并选出回复：第一条匹配且未用尽的脚本回复，否则回显
+func (s *Server) record(req chatRequest) Reply {
+	rec := Request{Model: req.Model, Stream: req.Stream, Messages: req.Messages, MaxTokens: req.MaxTokens}
+	for _, t := range req.Tools {
+		rec.Tools = append(rec.Tools, t.Function.Name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, rec)
+	for i, r := range s.replies {
+		if r.Times > 0 && s.used[i] >= r.Times {
+			continue
+		}
+		if r.Model != "" && r.Model != req.Model {
+			continue
+		}
+		if r.Match != "" && !mentions(req.Messages, r.Match) {
+			continue
+		}
+		s.used[i]++
+		return r
+	}
+	return echo(req)
+}
+
+func mentions(msgs []llm.Message, text string) bool {
+	for _, m := range msgs {
+		if strings.Contains(m.Content, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// echo 回显最后一条用户消息（截断），末尾附结构化结论，使预测流水线能完整走通
+func echo(req chatRequest) Reply {
+	last := ""
+	for i := len(req.Messages) - 1; i >= 0; i-- {
+		if req.Messages[i].Role == "user" {
+			last = req.Messages[i].Content
+			break
+		}
+	}
+	if r := []rune(last); len(r) > 200 {
+		last = string(r[:200]) + "…"
+	}
+	quoted := "> " + strings.ReplaceAll(strings.TrimSpace(last), "\n", "\n> ")
+	return Reply{
+		Reasoning: fmt.Sprintf("模拟模型 %s 收到 %d 条消息。", req.Model, len(req.Messages)),
+		Content: fmt.Sprintf("## 模拟回答\n\n这是本地模拟服务的回显（模型 %s），不代表任何分析结论。\n\n%s\n\n"+
+			"```json\n{\"direction\":\"neutral\",\"confidence\":0.5,\"change_low_pct\":-1,\"change_high_pct\":1}\n```",
+			req.Model, quoted),
+		FinishReason: "stop",
+	}
+}
+
+// writeStream 以 SSE 分片返回：先 reasoning_content，再 content，然后工具调用与 finish_reason，按需附 usage 分片
+func (s *Server) writeStream(w http.ResponseWriter, r *http.Request, req chatRequest, reply Reply, usage map[string]int) {
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	delay := s.ChunkDelay
+	if reply.ChunkDelayMs > 0 {
+		delay = time.Duration(reply.ChunkDelayMs) * time.Millisecond
+	}
+	first := true
+	send := func(delta map[string]interface{}, finish interface{}) bool {
+		if !first && delay > 0 && !sleep(r, delay) {
+			return false
+		}
+		first = false
+		bs, _ := json.Marshal(map[string]interface{}{
+			"object":  "chat.completion.chunk",
+			"model":   req.Model,
+			"choices": []interface{}{map[string]interface{}{"index": 0, "delta": delta, "finish_reason": finish}},
+		})
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", bs); err != nil {
+			return false
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return true
+	}
+	for _, part := range s.chunks(reply.Reasoning) {
+		if !send(map[string]interface{}{"reasoning_content": part}, nil) {
+			return
+		}
+	}
+	for _, part := range s.chunks(reply.Content) {
+		if !send(map[string]interface{}{"content": part}, nil) {
+			return
+		}
+	}
+	for i, c := range reply.ToolCalls {
+		// 参数分两片发送，模拟服务商按片段下发 arguments
+		half := len(c.Function.Arguments) / 2
+		head := map[string]interface{}{"index": i, "id": c.ID, "type": "function", "function": map[string]string{"name": c.Function.Name, "arguments": c.Function.Arguments[:half]}}
+		tail := map[string]interface{}{"index": i, "function": map[string]string{"arguments": c.Function.Arguments[half:]}}
+		if !send(map[string]interface{}{"tool_calls": []interface{}{head}}, nil) || !send(map[string]interface{}{"tool_calls": []interface{}{tail}}, nil) {
+			return
+		}
+	}
+	if !send(map[string]interface{}{}, reply.FinishReason) {
+		return
+	}
+	if req.StreamOptions != nil && req.StreamOptions.IncludeUsage {
+		bs, _ := json.Marshal(map[string]interface{}{"object": "chat.completion.chunk", "model": req.Model, "choices": []interface{}{}, "usage": usage})
+		fmt.Fprintf(w, "data: %s\n\n", bs)
+	}
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+// chunks 按字符数切分文本
+func (s *Server) chunks(text string) []string {
+	size := s.ChunkSize
+	if size <= 0 {
+		size = 8
+	}
+	var out []string
+	r := []rune(text)
+	for i := 0; i < len(r); i += size {
+		out = append(out, string(r[i:min(i+size, len(r))]))
+	}
+	return out
+}
+
+// estimateUsage 按字符数粗略估计 token 用量（约 2 字符 1 token）
+func estimateUsage(req chatRequest, reply Reply) map[string]int {
+	prompt := 0
+	for _, m := range req.Messages {
+		prompt += len([]rune(m.Content))
+	}
+	completion := len([]rune(reply.Content)) + len([]rune(reply.Reasoning))
+	for _, c := range reply.ToolCalls {
+		completion += len(c.Function.Arguments)
+	}
+	p, c := (prompt+1)/2, (completion+1)/2
+	return map[string]int{"prompt_tokens": p, "completion_tokens": c, "total_tokens": p + c}
+}
+
+func writeError(w http.ResponseWriter, status int, code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"code": code, "message": msg}})
+}
+
+// sleep 等待 d，客户端断开时返回 false
+func sleep(r *http.Request, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
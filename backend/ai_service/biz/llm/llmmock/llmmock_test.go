@@ -0,0 +1,291 @@
+package llmmock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	"hk_stock_assistant/backend/ai_service/biz/llm/llmmock"
+)
+
+// newClient 启动模拟服务并创建指向它的客户端；每个测试的服务地址不同，限流器互不影响
+func newClient(t *testing.T, replies ...llmmock.Reply) (*llmmock.Server, *llm.Client) {
+	t.Helper()
+	mock := llmmock.New(replies...)
+	ts := httptest.NewServer(mock)
+	t.Cleanup(ts.Close)
+	t.Setenv("ZHIPU_API_KEY", "")
+	t.Setenv("LLM_PROVIDERS", "")
+	t.Setenv("LLM_API_KEY", "mock")
+	t.Setenv("LLM_BASE_URL", ts.URL+"/v1")
+	t.Setenv("LLM_MODEL", "mock")
+	t.Setenv("LLM_RETRY_BASE_MS", "1")
+	t.Setenv("LLM_RATE_PER_MIN", "0")
+	return mock, llm.NewFromEnv()
+}
+
+func ask(text string) llm.Request {
+	return llm.Request{Messages: []llm.Message{{Role: "user", Content: text}}}
+}
+
+func TestCompleteJSON(t *testing.T) {
+	mock, c := newClient(t, llmmock.Reply{Content: "回答", Reasoning: "思考"})
+	resp, err := c.Complete(context.Background(), ask("你好"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Content != "回答" || resp.Reasoning != "思考" || resp.FinishReason != "stop" || resp.Model != "mock" {
+		t.Errorf("resp = %+v", resp)
+	}
+	if resp.Usage.TotalTokens == 0 || resp.Usage.TotalTokens != resp.Usage.PromptTokens+resp.Usage.CompletionTokens {
+		t.Errorf("usage = %+v", resp.Usage)
+	}
+	reqs := mock.Requests()
+	if len(reqs) != 1 || reqs[0].Stream || reqs[0].Messages[0].Content != "你好" {
+		t.Errorf("requests = %+v", reqs)
+	}
+}
+
+func TestCompleteStream(t *testing.T) {
+	mock, c := newClient(t, llmmock.Reply{Content: "这是一段较长的最终回答内容", Reasoning: "先想一想再回答问题"})
+	mock.ChunkSize = 3
+	var kinds []string
+	var reasoning, content strings.Builder
+	resp, err := c.Complete(context.Background(), ask("q"), func(kind, text string) error {
+		if len(kinds) == 0 || kinds[len(kinds)-1] != kind {
+			kinds = append(kinds, kind)
+		}
+		if kind == llm.DeltaReasoning {
+			reasoning.WriteString(text)
+		} else {
+			content.WriteString(text)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(kinds, ",") != "reasoning,content" {
+		t.Errorf("delta order = %v, want reasoning then content", kinds)
+	}
+	if reasoning.String() != "先想一想再回答问题" || resp.Reasoning != reasoning.String() {
+		t.Errorf("reasoning = %q / %q", reasoning.String(), resp.Reasoning)
+	}
+	if content.String() != "这是一段较长的最终回答内容" || resp.Content != content.String() {
+		t.Errorf("content = %q / %q", content.String(), resp.Content)
+	}
+	if resp.Usage.TotalTokens == 0 {
+		t.Error("stream usage chunk (include_usage) not parsed")
+	}
+	if reqs := mock.Requests(); len(reqs) != 1 || !reqs[0].Stream {
+		t.Errorf("requests = %+v", reqs)
+	}
+}
+
+func TestStreamToolCalls(t *testing.T) {
+	call := llm.ToolCall{ID: "call_1", Type: "function", Function: llm.FunctionCall{Name: "get_kline", Arguments: `{"code":"hk00700","days":30}`}}
+	_, c := newClient(t, llmmock.Reply{ToolCalls: []llm.ToolCall{call}})
+	resp, err := c.Complete(context.Background(), ask("q"), func(string, string) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FinishReason != "tool_calls" || len(resp.ToolCalls) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if got := resp.ToolCalls[0]; got.ID != call.ID || got.Function != call.Function {
+		t.Errorf("tool call = %+v, want %+v (arguments reassembled from fragments)", got, call)
+	}
+}
+
+func TestStreamNegativeToolIndex(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"部分\"}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":-1,\"function\":{\"name\":\"get_quote\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	t.Cleanup(ts.Close)
+	newClient(t)
+	t.Setenv("LLM_BASE_URL", ts.URL+"/v1")
+	resp, err := llm.NewFromEnv().Complete(context.Background(), ask("q"), func(string, string) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "index -1") {
+		t.Fatalf("err = %v, want invalid tool_calls index", err)
+	}
+	if resp == nil || resp.Content != "部分" {
+		t.Errorf("resp = %+v, want the partial content", resp)
+	}
+}
+
+func TestFinishReasonLength(t *testing.T) {
+	for _, stream := range []bool{false, true} {
+		_, c := newClient(t, llmmock.Reply{Reasoning: "想到一半", FinishReason: "length"})
+		var onDelta llm.DeltaFunc
+		if stream {
+			onDelta = func(string, string) error { return nil }
+		}
+		resp, err := c.Complete(context.Background(), ask("q"), onDelta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.FinishReason != "length" || resp.Content != "" || resp.Reasoning != "想到一半" {
+			t.Errorf("stream=%v resp = %+v", stream, resp)
+		}
+	}
+}
+
+func TestRetryAfter429(t *testing.T) {
+	mock, c := newClient(t, llmmock.Reply{Status: 429, RetryAfter: 1, Times: 1}, llmmock.Reply{Content: "ok"})
+	var waits []llm.WaitStatus
+	req := ask("q")
+	req.OnWait = func(ws llm.WaitStatus) { waits = append(waits, ws) }
+	start := time.Now()
+	resp, err := c.Complete(context.Background(), req, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Content != "ok" || len(mock.Requests()) != 2 {
+		t.Errorf("content = %q, requests = %d", resp.Content, len(mock.Requests()))
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least Retry-After 1s", elapsed)
+	}
+	if len(waits) == 0 || waits[0].Reason != llm.WaitRetry || waits[0].Attempt != 1 || waits[0].DelayMs < 1000 {
+		t.Errorf("waits = %+v", waits)
+	}
+}
+
+func TestRateLimitedExhausted(t *testing.T) {
+	mock, c := newClient(t, llmmock.Reply{Status: 429})
+	t.Setenv("LLM_MAX_RETRIES", "2")
+	_, err := c.Complete(context.Background(), ask("q"), nil)
+	var ie *i18n.Error
+	if !errors.As(err, &ie) || ie.Key != i18n.MsgRateLimited {
+		t.Fatalf("err = %v, want %s", err, i18n.MsgRateLimited)
+	}
+	var se *llm.StatusError
+	if !errors.As(err, &se) || se.Code != 429 {
+		t.Errorf("wrapped err = %v, want StatusError 429", err)
+	}
+	if n := len(mock.Requests()); n != 3 {
+		t.Errorf("requests = %d, want 1 + 2 retries", n)
+	}
+}
+
+func TestErrorBodies(t *testing.T) {
+	tests := []struct {
+		name     string
+		replies  []llmmock.Reply
+		wantErr  string
+		wantCode int
+		requests int
+	}{
+		{"400 不重试", []llmmock.Reply{{Status: 400, Error: "bad tools"}}, "bad tools", 400, 1},
+		{"200 + error 体", []llmmock.Reply{{Error: "content filtered"}}, "LLM 错误: content filtered", 0, 1},
+		{"500 重试后成功", []llmmock.Reply{{Status: 500, Times: 1}, {Content: "ok"}}, "", 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, c := newClient(t, tt.replies...)
+			resp, err := c.Complete(context.Background(), ask("q"), nil)
+			if n := len(mock.Requests()); n != tt.requests {
+				t.Errorf("requests = %d, want %d", n, tt.requests)
+			}
+			if tt.wantErr == "" {
+				if err != nil || resp.Content != "ok" {
+					t.Fatalf("resp = %+v, err = %v", resp, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+			}
+			var se *llm.StatusError
+			if tt.wantCode != 0 && (!errors.As(err, &se) || se.Code != tt.wantCode) {
+				t.Errorf("err = %v, want StatusError %d", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCancel(t *testing.T) {
+	for _, reply := range []llmmock.Reply{
+		{DelayMs: 5000, Content: "late"},                      // 等待响应头时取消
+		{ChunkDelayMs: 200, Content: strings.Repeat("x", 80)}, // 流式输出中途取消
+	} {
+		_, c := newClient(t, reply)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		resp, err := c.Complete(ctx, ask("q"), func(string, string) error { return nil })
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context deadline", err)
+		}
+		// 请求已发出，取消时仍返回部分响应供调用方估算用量
+		if resp == nil || resp.Model != "mock" || !strings.HasPrefix(reply.Content, resp.Content) {
+			t.Errorf("partial resp = %+v", resp)
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("cancel took %v", elapsed)
+		}
+	}
+}
+
+func TestConcurrencyQueue(t *testing.T) {
+	t.Setenv("LLM_MAX_CONCURRENCY", "1")
+	_, c := newClient(t, llmmock.Reply{DelayMs: 200, Content: "ok"})
+	var mu sync.Mutex
+	var waits []llm.WaitStatus
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := ask("q")
+			req.OnWait = func(ws llm.WaitStatus) {
+				mu.Lock()
+				waits = append(waits, ws)
+				mu.Unlock()
+			}
+			if _, err := c.Complete(context.Background(), req, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(waits) != 1 || waits[0].Reason != llm.WaitQueue || waits[0].Position != 1 {
+		t.Errorf("waits = %+v, want one queue wait at position 1", waits)
+	}
+}
+
+func TestScriptMatching(t *testing.T) {
+	mock, c := newClient(t,
+		llmmock.Reply{Model: "other", Content: "other model"},
+		llmmock.Reply{Match: "腾讯", Content: "matched", Times: 1},
+	)
+	ctx := context.Background()
+	resp, _ := c.Complete(ctx, ask("分析腾讯"), nil)
+	if resp.Content != "matched" {
+		t.Errorf("first = %q, want matched", resp.Content)
+	}
+	resp, _ = c.Complete(ctx, ask("分析腾讯"), nil)
+	if !strings.Contains(resp.Content, "模拟回答") || !strings.Contains(resp.Content, `"direction"`) {
+		t.Errorf("after Times used up = %q, want echo with verdict JSON", resp.Content)
+	}
+	req := ask("x")
+	req.Model = "other"
+	if resp, _ = c.Complete(ctx, req, nil); resp.Content != "other model" {
+		t.Errorf("model match = %q", resp.Content)
+	}
+	mock.Reset()
+	if resp, _ = c.Complete(ctx, ask("腾讯"), nil); resp.Content != "matched" || len(mock.Requests()) != 1 {
+		t.Errorf("after Reset = %q, requests %d", resp.Content, len(mock.Requests()))
+	}
+}
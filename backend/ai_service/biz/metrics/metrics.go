@@ -0,0 +1,83 @@
+// Package metrics 进程内计数器，以 Prometheus 文本格式在 /metrics 暴露（不引入额外依赖）。
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	mu       sync.Mutex
+	counters = map[string]float64{} // key 为 name{label="v",...}
+	help     = map[string]string{}
+)
+
+// Describe 登记指标说明，出现在输出的 # HELP 行
+func Describe(name, text string) {
+	mu.Lock()
+	defer mu.Unlock()
+	help[name] = text
+}
+
+// Add 计数器增加 v；labels 为成对的 key、value
+func Add(name string, v float64, labels ...string) {
+	key := seriesKey(name, labels)
+	mu.Lock()
+	defer mu.Unlock()
+	counters[key] += v
+}
+
+// Inc 计数器加 1
+func Inc(name string, labels ...string) { Add(name, 1, labels...) }
+
+// Get 读取计数值，labels 需与写入时顺序一致
+func Get(name string, labels ...string) float64 {
+	key := seriesKey(name, labels)
+	mu.Lock()
+	defer mu.Unlock()
+	return counters[key]
+}
+
+// seriesKey name{k="v",...}
+func seriesKey(name string, labels []string) string {
+	if len(labels) < 2 {
+		return name
+	}
+	parts := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		parts = append(parts, fmt.Sprintf("%s=%q", labels[i], labels[i+1]))
+	}
+	return name + "{" + strings.Join(parts, ",") + "}"
+}
+
+// Handler GET /metrics
+func Handler(w http.ResponseWriter, r *http.Request) {
+	mu.Lock()
+	keys := make([]string, 0, len(counters))
+	for k := range counters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	described := map[string]bool{}
+	for _, k := range keys {
+		name := k
+		if i := strings.IndexByte(k, '{'); i >= 0 {
+			name = k[:i]
+		}
+		if !described[name] {
+			described[name] = true
+			if h := help[name]; h != "" {
+				fmt.Fprintf(&b, "# HELP %s %s\n", name, h)
+			}
+			fmt.Fprintf(&b, "# TYPE %s counter\n", name)
+		}
+		fmt.Fprintf(&b, "%s %g\n", k, counters[k])
+	}
+	mu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = w.Write([]byte(b.String()))
+}
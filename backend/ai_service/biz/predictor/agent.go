@@ -0,0 +1,174 @@
+package predictor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/chat"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+)
+
+// defaultToolSteps 默认最多几轮工具调用
+const defaultToolSteps = 4
+
+// toolStepsFromEnv AI_TOOL_MAX_STEPS 覆盖最多工具调用轮数，0 关闭工具调用
+func toolStepsFromEnv() int {
+	if s := strings.TrimSpace(os.Getenv("AI_TOOL_MAX_STEPS")); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultToolSteps
+}
+
+// ToolInvocation 一次工具调用记录，随结果返回；SSE 中每次调用后以 tool 事件发送。
+type ToolInvocation struct {
+	Role      string `json:"role,omitempty"`  // 辩论模式下发起调用的角色
+	Model     string `json:"model,omitempty"` // 多模型对比时发起调用的模型
+	Step      int    `json:"step"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+	Result    string `json:"result,omitempty"` // 回传给模型的结果（截断）
+	Error     string `json:"error,omitempty"`
+	ElapsedMs int64  `json:"elapsed_ms"`
+}
+
+// toolResultPreview 调用记录中保留的结果长度
+const toolResultPreview = 300
+
+// defaultNoToolsTTL 判定不支持工具调用后默认多久重新尝试
+const defaultNoToolsTTL = time.Hour
+
+// noToolsTTLFromEnv AI_NO_TOOLS_TTL_MIN 覆盖重新尝试工具调用的间隔（分钟），0 表示在进程生命周期内不再尝试
+func noToolsTTLFromEnv() time.Duration {
+	if s := strings.TrimSpace(os.Getenv("AI_NO_TOOLS_TTL_MIN")); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			return time.Duration(n) * time.Minute
+		}
+	}
+	return defaultNoToolsTTL
+}
+
+// toolsEnabled 该模型是否启用工具调用（全局关闭或此前判定不支持且未过期时为 false）
+func (p *Predictor) toolsEnabled(model string) bool {
+	if p.toolSteps <= 0 {
+		return false
+	}
+	v, unsupported := p.noTools.Load(model)
+	if !unsupported {
+		return true
+	}
+	if p.noToolsTTL <= 0 || time.Since(v.(time.Time)) < p.noToolsTTL {
+		return false
+	}
+	if p.noTools.CompareAndDelete(model, v) {
+		log.Printf("[Predict] model=%s tools re-enabled after %v", model, p.noToolsTTL)
+	}
+	return true
+}
+
+// toolsUnsupported 带 tools 的请求被拒（400/422）且错误信息提及工具调用时视为模型不支持工具调用；
+// 其他 400（如上下文超长、参数错误）不影响后续请求是否携带 tools。
+func toolsUnsupported(err error) bool {
+	var se *llm.StatusError
+	if !errors.As(err, &se) || (se.Code != http.StatusBadRequest && se.Code != http.StatusUnprocessableEntity) {
+		return false
+	}
+	body := strings.ToLower(se.Body)
+	return strings.Contains(body, "tool") || strings.Contains(body, "function")
+}
+
+// complete 带工具调用的 LLM 对话循环：模型返回 tool_calls 时执行工具并回传结果，直到模型给出回答；
+// 超过 toolSteps 轮后以 tool_choice=none 要求模型直接作答。模型不支持工具时去掉 tools 重试并记住该模型。
+func (p *Predictor) complete(ctx context.Context, model string, messages []llm.Message, useTools bool, emit EventFunc) (*llm.Response, []ToolInvocation, error) {
+	var onDelta llm.DeltaFunc
+	var onWait llm.WaitFunc
+	if emit != nil {
+		onDelta = func(kind, text string) error { return emit(Event{Type: kind, Text: text}) }
+		onWait = func(ws llm.WaitStatus) { _ = emit(Event{Type: EventQueue, Data: ws}) }
+	}
+	var calls []ToolInvocation
+	for step := 1; ; step++ {
+		req := llm.Request{Model: model, Messages: messages, OnWait: onWait}
+		if useTools {
+			req.Tools = p.tools.Definitions()
+			if step > p.toolSteps {
+				req.ToolChoice = "none"
+			}
+		}
+		resp, err := p.llm.Complete(ctx, req, onDelta)
+		if resp != nil { // 出错时为已发出请求的部分响应（取消、流中断），同样计入用量
+			p.recordUsage(ctx, req, resp)
+		}
+		if err != nil && useTools && toolsUnsupported(err) {
+			log.Printf("[Predict] model=%s rejected tools, fallback to plain prompt: %v", model, err)
+			p.noTools.Store(model, time.Now())
+			useTools = false
+			step--
+			continue
+		}
+		if err != nil {
+			return nil, calls, err
+		}
+		if !useTools || len(resp.ToolCalls) == 0 || step > p.toolSteps {
+			return resp, calls, nil
+		}
+		messages = append(messages, llm.Message{Role: "assistant", Content: resp.Content, ToolCalls: resp.ToolCalls})
+		for _, tc := range resp.ToolCalls {
+			inv, content := p.callTool(ctx, step, tc)
+			calls = append(calls, inv)
+			messages = append(messages, llm.Message{Role: "tool", ToolCallID: tc.ID, Content: content})
+			if emit != nil {
+				if err := emit(Event{Type: EventTool, Data: inv}); err != nil {
+					return nil, calls, err
+				}
+			}
+		}
+	}
+}
+
+// recordUsage 记录本次调用的 token 用量；服务商未返回 usage 时（含被取消的调用）按提示词与已收到内容的字符数估算
+func (p *Predictor) recordUsage(ctx context.Context, req llm.Request, resp *llm.Response) {
+	u := resp.Usage
+	estimated := u.TotalTokens == 0
+	if estimated {
+		for _, m := range req.Messages {
+			u.PromptTokens += int64(chat.EstimateTokens(m.Content))
+			for _, tc := range m.ToolCalls {
+				u.PromptTokens += int64(chat.EstimateTokens(tc.Function.Arguments))
+			}
+		}
+		u.ReasoningTokens = int64(chat.EstimateTokens(resp.Reasoning))
+		u.CompletionTokens = int64(chat.EstimateTokens(resp.Content)) + u.ReasoningTokens
+		u.TotalTokens = u.PromptTokens + u.CompletionTokens
+	}
+	p.usage.Record(ctx, resp.Model, u, estimated)
+}
+
+// callTool 执行单个工具调用；出错时把错误作为结果回传给模型，由模型决定是否换用其他数据。
+func (p *Predictor) callTool(ctx context.Context, step int, tc llm.ToolCall) (ToolInvocation, string) {
+	start := time.Now()
+	result, err := p.tools.Call(ctx, tc.Function.Name, tc.Function.Arguments)
+	inv := ToolInvocation{
+		Step:      step,
+		Name:      tc.Function.Name,
+		Arguments: tc.Function.Arguments,
+		ElapsedMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		log.Printf("[Predict] tool %s(%s) failed: %v", inv.Name, inv.Arguments, err)
+		inv.Error = err.Error()
+		bs, _ := json.Marshal(map[string]string{"error": inv.Error})
+		return inv, string(bs)
+	}
+	log.Printf("[Predict] tool %s(%s) ok in %dms", inv.Name, inv.Arguments, inv.ElapsedMs)
+	inv.Result = truncate(result, toolResultPreview)
+	return inv, result
+}
@@ -0,0 +1,121 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Backtest 基于历史日 K 预测（回测用）：bars 为截至预测日（含）的个股日 K（升序），index 为同期恒指日 K。
+// 与 Predict 共用 prompt 与后处理，但不走结果缓存、不调用工具、不写预测记录。
+func (p *Predictor) Backtest(ctx context.Context, req Request, bars, index []*stock.KLine) (*Result, error) {
+	req, err := p.prepare(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(bars) == 0 {
+		return nil, fmt.Errorf("回测需要日 K 数据")
+	}
+	return p.replay(ctx, req, historicalSnapshot(req.Code, req.Days, bars, index))
+}
+
+// Replay 基于录制的数据快照预测（prompt 评测用，快照由 NewSnapshot 构造），其余同 Backtest。不支持多模型对比。
+func (p *Predictor) Replay(ctx context.Context, req Request, snap *Snapshot) (*Result, error) {
+	if len(req.Models) > 0 {
+		return nil, fmt.Errorf("快照预测不支持多模型对比")
+	}
+	req, err := p.prepare(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Code, req.Days = snap.Code, snap.Days
+	return p.replay(ctx, req, snap)
+}
+
+func (p *Predictor) replay(ctx context.Context, req Request, snap *Snapshot) (*Result, error) {
+	if req.Model == quant.ModelName {
+		return p.quantPredict(ctx, req, snap)
+	}
+	return p.generate(ctx, req, snap, nil)
+}
+
+// NewSnapshot 由录制的行情、大盘指数与日 K（升序）构造历史快照，文本格式与实时拉取一致；
+// 时间取行情时间戳（无法解析时为最后一根日 K 的收盘时刻），IsTrading 由调用方按需设置。
+func NewSnapshot(code string, days int32, quote *stock.StockInfo, indices []*stock.MarketIndex, bars []*stock.KLine) *Snapshot {
+	if len(bars) > technicalBars {
+		bars = bars[len(bars)-technicalBars:]
+	}
+	snap := &Snapshot{Code: code, Days: days, Historical: true, Quote: quote, Indices: indices, Bars: bars, Time: time.Now()}
+	if n := len(bars); n > 0 {
+		snap.Time = closeTime(bars[n-1].Date)
+	}
+	snap.Stock, snap.Market = "无行情数据", "无大盘数据"
+	if quote != nil {
+		snap.Stock = quoteText(quote)
+		if t, err := time.ParseInLocation("2006-01-02 15:04:05", quote.Timestamp, snap.Time.Location()); err == nil {
+			snap.Time = t
+		}
+	}
+	if len(indices) > 0 {
+		snap.Market = indicesText(indices)
+	}
+	snap.TechnicalText = "无K线数据"
+	if len(bars) > 0 {
+		snap.Technical = computeTechnical(bars)
+		snap.TechnicalText = snap.Technical.String()
+	}
+	snap.Bands, snap.BandsText = simulateBands(snap)
+	return snap
+}
+
+// historicalSnapshot 以最后一根日 K 的收盘为“现价”还原当日收盘后的数据快照：个股行情与恒指取自日 K，
+// 技术指标与统计区间按截至当日的 K 线计算。
+func historicalSnapshot(code string, days int32, bars, index []*stock.KLine) *Snapshot {
+	if len(bars) > technicalBars {
+		bars = bars[len(bars)-technicalBars:]
+	}
+	last := bars[len(bars)-1]
+	snap := &Snapshot{Code: code, Days: days, Historical: true, Bars: bars, IndexBars: index}
+	snap.Time = closeTime(last.Date)
+
+	q := &stock.StockInfo{Code: code, CurrentPrice: last.Close, Volume: last.Volume, Timestamp: last.Date}
+	if n := len(bars); n >= 2 && bars[n-2].Close > 0 {
+		q.ChangePercent = (last.Close/bars[n-2].Close - 1) * 100
+	}
+	snap.Quote = q
+	snap.Stock = fmt.Sprintf("代码=%s, 收盘=%.2f（%s）, 涨跌幅=%.2f%%, 成交量=%d",
+		code, q.CurrentPrice, last.Date, q.ChangePercent, q.Volume)
+
+	snap.Market = "无大盘数据"
+	if n := len(index); n > 0 {
+		idx := &stock.MarketIndex{Name: "恒生指数", Value: index[n-1].Close}
+		if n >= 2 && index[n-2].Close > 0 {
+			idx.Change = index[n-1].Close - index[n-2].Close
+			idx.ChangePercent = idx.Change / index[n-2].Close * 100
+		}
+		snap.Indices = []*stock.MarketIndex{idx}
+		snap.Market = fmt.Sprintf("%s: %.2f, 涨跌%.2f%%, 变动%.2f（%s）",
+			idx.Name, idx.Value, idx.ChangePercent, idx.Change, index[n-1].Date)
+	}
+
+	snap.Technical = computeTechnical(bars)
+	snap.TechnicalText = snap.Technical.String()
+	snap.Bands, snap.BandsText = simulateBands(snap)
+	return snap
+}
+
+// closeTime 交易日（YYYY-MM-DD）港股收盘时刻，日期无法解析时为当前时间
+func closeTime(date string) time.Time {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		loc = time.FixedZone("HKT", 8*3600)
+	}
+	t, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Now()
+	}
+	return t.Add(16 * time.Hour)
+}
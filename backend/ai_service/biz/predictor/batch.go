@@ -0,0 +1,148 @@
+package predictor
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+)
+
+// MaxBatchCodes 批量预测一次最多的股票数
+const MaxBatchCodes = 30
+
+// 批量预测事件类型
+const (
+	EventItem   = "item"   // Data 为 BatchItem，每只股票预测结束（成功或失败）后发送
+	EventDigest = "digest" // Data 为 *Digest，全部结束后发送
+)
+
+// BatchRequest 批量预测请求：Codes 共用其余参数
+type BatchRequest struct {
+	Codes           []string `json:"codes"`
+	Days            int32    `json:"days"`
+	Model           string   `json:"model,omitempty"`
+	TemplateVersion string   `json:"template_version,omitempty"`
+	Mode            string   `json:"mode,omitempty"`
+	ForceRefresh    bool     `json:"force_refresh,omitempty"`
+	Language        string   `json:"language,omitempty"` // 输出语言，同 Request.Language；单只失败的错误文案也按该语言
+}
+
+// BatchItem 单只股票的预测结果或错误
+type BatchItem struct {
+	Index  int     `json:"index"` // 在去重后代码列表中的位置
+	Total  int     `json:"total"`
+	Code   string  `json:"code"`
+	Result *Result `json:"result,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// batchConcurrency 同时进行的预测数，AI_BATCH_CONCURRENCY 覆盖（默认 3）
+func batchConcurrency() int {
+	if s := strings.TrimSpace(os.Getenv("AI_BATCH_CONCURRENCY")); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 3
+}
+
+// BatchPredict 对自选股列表逐只预测（并发数受 AI_BATCH_CONCURRENCY 限制），单只失败不影响其他；
+// 每只结束后发送 item 事件，全部结束后汇总排序为 Digest 并发送 digest 事件。emit 可为 nil。
+func (p *Predictor) BatchPredict(ctx context.Context, req BatchRequest, emit EventFunc) (*Digest, error) {
+	lang, err := i18n.Normalize(req.Language)
+	if err != nil {
+		return nil, err
+	}
+	req.Language = lang
+	codes := dedupCodes(req.Codes)
+	if len(codes) == 0 {
+		return nil, i18n.Errorf(i18n.MsgBatchNoCodes)
+	}
+	if len(codes) > MaxBatchCodes {
+		return nil, i18n.Errorf(i18n.MsgBatchTooMany, MaxBatchCodes)
+	}
+	if req.Mode != "" && req.Mode != ModeSingle && req.Mode != ModeDebate {
+		return nil, i18n.Errorf(i18n.MsgUnsupportedMode, req.Mode, ModeSingle, ModeDebate)
+	}
+	if err := CheckDays(req.Days); err != nil {
+		return nil, err
+	}
+
+	// 事件写入失败（客户端已断开）时取消，排队中的股票不再预测
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	items := make([]BatchItem, len(codes))
+	sem := make(chan struct{}, batchConcurrency())
+	var mu sync.Mutex // 串行化事件写入
+	var emitErr error
+	var wg sync.WaitGroup
+	for i, code := range codes {
+		wg.Add(1)
+		go func(i int, code string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+			}
+			if err := ctx.Err(); err != nil { // 取得名额时可能已取消
+				items[i] = BatchItem{Index: i, Total: len(codes), Code: code, Error: err.Error()}
+				return
+			}
+			item := BatchItem{Index: i, Total: len(codes), Code: code}
+			res, err := p.Predict(ctx, Request{
+				Code: code, Days: req.Days, Model: req.Model, TemplateVersion: req.TemplateVersion, Mode: req.Mode,
+				ForceRefresh: req.ForceRefresh, Language: req.Language,
+			})
+			if err != nil {
+				item.Error = i18n.Localize(err, req.Language)
+			} else {
+				item.Result = res
+			}
+			items[i] = item
+			if emit == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if emitErr == nil {
+				if emitErr = emit(Event{Type: EventItem, Data: item}); emitErr != nil {
+					cancel()
+				}
+			}
+		}(i, code)
+	}
+	wg.Wait()
+	if emitErr != nil {
+		return nil, emitErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	digest := BuildDigest(req, items)
+	if emit != nil {
+		if err := emit(Event{Type: EventDigest, Data: digest}); err != nil {
+			return nil, err
+		}
+	}
+	return digest, nil
+}
+
+// dedupCodes 去掉空白与重复代码，保持原顺序
+func dedupCodes(codes []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = strings.TrimSpace(c)
+		if c == "" || seen[strings.ToLower(c)] {
+			continue
+		}
+		seen[strings.ToLower(c)] = true
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,262 @@
+package predictor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// resultCache 生成结果缓存：预测结果键为 (code, days, model, mode, 模板版本, 数据快照指纹)，盘中与收盘后 TTL 不同；
+// 市场评论键为 (交易日, 时段, 语言)，到时段结束时过期。相同键的并发请求只调用一次 LLM，其余请求订阅同一事件流（in-flight 去重）。
+type resultCache struct {
+	openTTL, closedTTL time.Duration
+	maxEntries         int
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	flights map[string]*flight
+	running sync.WaitGroup // 进行中的生成，含已被全部订阅者取消、尚未返回的
+}
+
+type cacheEntry struct {
+	res     interface{} // *Result 或 *MarketCommentary，与各请求共享，返回前复制
+	events  []Event     // 生成时的流式事件（不含排队事件），命中时按原顺序重放
+	expires time.Time
+}
+
+// flight 进行中的一次生成；所有订阅者离开时取消（detached 时仍完成并写入缓存）
+type flight struct {
+	key      string
+	feed     *Feed
+	done     chan struct{}
+	res      interface{}
+	err      error
+	refs     int
+	detached bool
+	cancel   context.CancelFunc
+}
+
+// generateFunc 一次生成，返回结果与缓存到期时间（零值表示不缓存）
+type generateFunc func(ctx context.Context, emit EventFunc) (res interface{}, expires time.Time, err error)
+
+// newResultCache AI_CACHE_TTL_OPEN_SEC 盘中 TTL（默认 60），AI_CACHE_TTL_CLOSED_SEC 非交易时段 TTL（默认 1800），
+// 两者均为 0 时关闭缓存（仍做 in-flight 去重）；AI_CACHE_MAX_ENTRIES 最多缓存条数（默认 500）。
+func newResultCache() *resultCache {
+	return &resultCache{
+		openTTL:    time.Duration(envSeconds("AI_CACHE_TTL_OPEN_SEC", 60)) * time.Second,
+		closedTTL:  time.Duration(envSeconds("AI_CACHE_TTL_CLOSED_SEC", 1800)) * time.Second,
+		maxEntries: envSeconds("AI_CACHE_MAX_ENTRIES", 500),
+		entries:    map[string]*cacheEntry{},
+		flights:    map[string]*flight{},
+	}
+}
+
+func envSeconds(key string, def int) int {
+	if s := strings.TrimSpace(os.Getenv(key)); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return def
+}
+
+// Fingerprint 数据快照指纹：现价、涨跌幅、指数点位与技术面（最新 K 线日期与收盘价）。
+// 不含成交量与采集时间，盘中成交量持续变化但在缓存 TTL 内不影响结论。
+func (s *Snapshot) Fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%d|%v|", s.Code, s.Days, s.IsTrading)
+	if q := s.Quote; q != nil {
+		fmt.Fprintf(h, "q:%.3f,%.2f|", q.CurrentPrice, q.ChangePercent)
+	} else {
+		fmt.Fprintf(h, "q:%s|", s.Stock)
+	}
+	for _, idx := range s.Indices {
+		if idx != nil {
+			fmt.Fprintf(h, "i:%s,%.0f|", idx.Name, idx.Value)
+		}
+	}
+	if t := s.Technical; t != nil {
+		fmt.Fprintf(h, "t:%s,%.3f", t.AsOf, t.Close)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func cacheKey(req Request, snap *Snapshot) string {
+	return strings.Join([]string{req.Code, strconv.Itoa(int(req.Days)), req.Model, req.Mode, req.TemplateVersion, req.Language, snap.Fingerprint()}, "|")
+}
+
+func (c *resultCache) get(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil
+	}
+	return e
+}
+
+// expiry 预测结果的缓存到期时间；对应 TTL 为 0 时不缓存
+func (c *resultCache) expiry(trading bool) time.Time {
+	ttl := c.closedTTL
+	if trading {
+		ttl = c.openTTL
+	}
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
+
+func (c *resultCache) put(key string, res interface{}, events []Event, expires time.Time) {
+	now := time.Now()
+	if !expires.After(now) {
+		return
+	}
+	kept := make([]Event, 0, len(events))
+	for _, ev := range events {
+		if ev.Type != EventQueue {
+			kept = append(kept, ev)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = &cacheEntry{res: res, events: kept, expires: expires}
+	if len(c.entries) <= c.maxEntries {
+		return
+	}
+	var oldest string
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		} else if oldest == "" || e.expires.Before(c.entries[oldest].expires) {
+			oldest = k
+		}
+	}
+	if len(c.entries) > c.maxEntries && oldest != "" {
+		delete(c.entries, oldest)
+	}
+}
+
+// cachedGenerate 经缓存生成预测结果，返回结果的 Cached 表示未为本请求单独调用 LLM（缓存命中或与进行中的相同请求合并）。
+// 单次分析模式先选定模板版本（按权重 A/B 时随机），缓存按选定的 id@version 区分，生成也使用该版本。
+func (p *Predictor) cachedGenerate(ctx context.Context, req Request, snap *Snapshot, emit EventFunc) (*Result, error) {
+	if req.Mode != ModeDebate {
+		tmpl, err := p.prompts.Select(predictionTemplateID, req.TemplateVersion)
+		if err != nil {
+			return nil, err
+		}
+		req.TemplateVersion = tmpl.Key()
+	}
+	gen := func(ctx context.Context, emit EventFunc) (interface{}, time.Time, error) {
+		res, err := p.generate(ctx, req, snap, emit)
+		return res, p.cache.expiry(snap.IsTrading), err
+	}
+	v, cached, err := p.cache.do(ctx, cacheKey(req, snap), req.ForceRefresh, false, gen, emit)
+	if err != nil {
+		return nil, err
+	}
+	res := *v.(*Result) // 结果与缓存共享，返回副本
+	res.Cached = cached
+	return &res, nil
+}
+
+// do 先查缓存（force 时跳过），命中时重放事件；否则加入或发起相同键的生成并订阅其事件流。
+// 生成脱离请求 ctx 运行：detached 为 false 时最后一个订阅者离开即取消，为 true 时仍完成并写入缓存。
+// cached 表示结果来自缓存或与进行中的生成合并。
+func (c *resultCache) do(ctx context.Context, key string, force, detached bool, gen generateFunc, emit EventFunc) (res interface{}, cached bool, err error) {
+	if !force {
+		if e := c.get(key); e != nil {
+			log.Printf("[Cache] hit %s", key)
+			if emit != nil {
+				for _, ev := range e.events {
+					if err := emit(ev); err != nil {
+						return nil, false, err
+					}
+				}
+			}
+			return e.res, true, nil
+		}
+	}
+
+	c.mu.Lock()
+	f, joined := c.flights[key]
+	if !joined {
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{key: key, feed: NewFeed(), done: make(chan struct{}), detached: detached, cancel: cancel}
+		c.flights[key] = f
+		c.running.Add(1)
+		go func() {
+			defer c.running.Done()
+			defer cancel()
+			res, expires, err := gen(fctx, f.feed.Add)
+			events, _, _ := f.feed.Read(0)
+			if err == nil {
+				c.put(key, res, events, expires)
+			}
+			c.mu.Lock()
+			f.res, f.err = res, err
+			if c.flights[key] == f {
+				delete(c.flights, key)
+			}
+			c.mu.Unlock()
+			f.feed.Finish()
+			close(f.done)
+		}()
+	} else {
+		log.Printf("[Cache] join in-flight %s", key)
+	}
+	f.refs++
+	c.mu.Unlock()
+
+	res, err = f.wait(ctx, emit, c)
+	return res, joined, err
+}
+
+// wait 转发事件直到生成结束；ctx 取消时退订，最后一个订阅者离开则取消生成（detached 除外）
+func (f *flight) wait(ctx context.Context, emit EventFunc, c *resultCache) (interface{}, error) {
+	leave := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if f.refs--; f.refs == 0 && !f.detached {
+			f.cancel()
+			if c.flights[f.key] == f {
+				delete(c.flights, f.key) // 已取消，之后的相同请求重新发起
+			}
+		}
+	}
+	for i := 0; ; {
+		evs, done, wake := f.feed.Read(i)
+		if emit != nil {
+			for _, ev := range evs {
+				if err := emit(ev); err != nil {
+					leave()
+					return nil, err
+				}
+			}
+		}
+		i += len(evs)
+		if done {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			leave()
+			return nil, ctx.Err()
+		case <-wake:
+		}
+	}
+	<-f.done
+	leave()
+	return f.res, f.err
+}
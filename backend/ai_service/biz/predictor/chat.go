@@ -0,0 +1,126 @@
+package predictor
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/chat"
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+)
+
+// chatSystemTemplateID 追问会话 system prompt 模板 id
+const chatSystemTemplateID = "chat_system"
+
+// chatTokenBudget 每轮发送给模型的历史 token 上限（含 system），AI_CHAT_CONTEXT_TOKENS 覆盖
+func chatTokenBudget() int {
+	if s := strings.TrimSpace(os.Getenv("AI_CHAT_CONTEXT_TOKENS")); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 6000
+}
+
+// chatData chat_system 模板变量
+type chatData struct {
+	Code     string
+	Now      string
+	Context  string
+	Analysis string
+	Tools    string
+}
+
+// CreateChatSession 创建追问会话：指定 predictionID 时以该次预测的数据快照与结论为背景，否则现拉一份数据快照。
+func (p *Predictor) CreateChatSession(ctx context.Context, code, predictionID, model string) (*chat.Session, error) {
+	sess := &chat.Session{Code: code, PredictionID: predictionID, Model: model}
+	if predictionID != "" {
+		d, err := p.history.GetDetail(predictionID)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, i18n.Errorf(i18n.MsgPredictionNotFound, predictionID)
+			}
+			return nil, err
+		}
+		if sess.Code == "" {
+			sess.Code = d.Code
+		}
+		sess.Context, sess.Analysis = d.Context, d.Analysis
+	} else {
+		if code == "" {
+			return nil, i18n.Errorf(i18n.MsgChatNeedsCode)
+		}
+		sess.Context = p.gatherContext(ctx, code, 3).ContextText()
+	}
+	if err := p.chats.Create(sess); err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+// ChatSession 读取会话，不存在时返回 chat.ErrNotFound
+func (p *Predictor) ChatSession(id string) (*chat.Session, error) {
+	return p.chats.Get(id)
+}
+
+// Chat 在会话中追问一轮：历史按 token 预算截断后连同 system 背景发送，模型可调用工具；
+// 流式时发送 reasoning/content/tool 事件。成功后问答一并写入会话，返回助手回复。
+func (p *Predictor) Chat(ctx context.Context, sessionID, content string, emit EventFunc) (*chat.Message, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, i18n.Errorf(i18n.MsgContentRequired)
+	}
+	unlock := p.chats.Lock(sessionID)
+	defer unlock()
+	sess, err := p.chats.Get(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !p.llm.Configured() {
+		return nil, i18n.Errorf(i18n.MsgLLMNotConfigured)
+	}
+	model := sess.Model
+	if model == "" || model == quant.ModelName { // 规则模型不能对话，追问改用默认 LLM
+		model = p.llm.DefaultModel()
+	}
+	if model, err = p.usage.Admit(model); err != nil {
+		return nil, err
+	}
+	useTools := p.toolsEnabled(model)
+	data := chatData{Code: sess.Code, Now: time.Now().Format("2006-01-02 15:04:05"), Context: sess.Context, Analysis: sess.Analysis}
+	if useTools {
+		data.Tools = strings.Join(p.tools.Names(), "、")
+	}
+	tmpl, err := p.prompts.Select(chatSystemTemplateID, "")
+	if err != nil {
+		return nil, err
+	}
+	system, err := tmpl.Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	question := chat.Message{Role: "user", Content: content, Time: time.Now()}
+	messages := chat.Window(system, append(sess.Messages, question), chatTokenBudget())
+	resp, _, err := p.complete(ctx, model, messages, useTools, emit)
+	if err != nil {
+		return nil, err
+	}
+	answer := strings.TrimSpace(resp.Content)
+	if answer == "" {
+		answer = strings.TrimSpace(resp.Reasoning)
+	}
+	if answer == "" {
+		log.Printf("[Chat] LLM 返回 content 为空, finish_reason=%s", resp.FinishReason)
+		return nil, i18n.Errorf(i18n.MsgEmptyAnswer)
+	}
+	reply := chat.Message{Role: "assistant", Content: answer, Time: time.Now()}
+	if err := p.chats.Append(sessionID, question, reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
@@ -0,0 +1,241 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// commentaryTemplateID 市场评论使用的模板 id
+const commentaryTemplateID = "market_commentary"
+
+// commentaryTopN 评论数据中各排行的条数
+const commentaryTopN = 5
+
+// 市场时段（香港时间，周一至周五；周末归入周五收市后）
+const (
+	PhasePreOpen   = "pre_open"  // 0:00-9:30 开盘前
+	PhaseMorning   = "morning"   // 9:30-12:00 早市
+	PhaseLunch     = "lunch"     // 12:00-13:00 午间休市
+	PhaseAfternoon = "afternoon" // 13:00-16:00 午市
+	PhaseClosed    = "closed"    // 16:00 起收市后
+)
+
+// 市场评论流式事件类型
+const (
+	EventOverview   = "overview"   // Data 为 *stock.GetMarketOverviewResponse，调用 LLM 前发送
+	EventCommentary = "commentary" // Data 为 *MarketCommentary，生成完成后发送
+)
+
+// phaseNames 各语言的时段说明，渲染进模板的 TradingStatus
+var phaseNames = map[string]map[string]string{
+	i18n.ZhCN: {
+		PhasePreOpen:   "开盘前（数据为上一交易日收市）",
+		PhaseMorning:   "早市交易中（9:30-12:00）",
+		PhaseLunch:     "午间休市（早市已收）",
+		PhaseAfternoon: "午市交易中（13:00-16:00）",
+		PhaseClosed:    "已收市",
+	},
+	i18n.ZhTW: {
+		PhasePreOpen:   "開市前（數據為上一交易日收市）",
+		PhaseMorning:   "早市交易中（9:30-12:00）",
+		PhaseLunch:     "午間休市（早市已收）",
+		PhaseAfternoon: "午市交易中（13:00-16:00）",
+		PhaseClosed:    "已收市",
+	},
+	i18n.En: {
+		PhasePreOpen:   "pre-open (figures are from the previous close)",
+		PhaseMorning:   "morning session (9:30-12:00)",
+		PhaseLunch:     "lunch break (morning session closed)",
+		PhaseAfternoon: "afternoon session (13:00-16:00)",
+		PhaseClosed:    "market closed",
+	},
+}
+
+// MarketCommentaryRequest 市场评论请求
+type MarketCommentaryRequest struct {
+	Language     string `json:"language,omitempty"`      // 输出语言，见 i18n.Normalize
+	ForceRefresh bool   `json:"force_refresh,omitempty"` // 跳过本时段的缓存重新生成
+}
+
+// MarketCommentary 大盘评论：同一交易日、同一时段、同一语言只生成一次，到时段结束时过期。
+type MarketCommentary struct {
+	SessionDate     string                           `json:"session_date"` // 所属交易日（周末为上周五）
+	Phase           string                           `json:"phase"`
+	Commentary      string                           `json:"commentary"`
+	Model           string                           `json:"model"`
+	TemplateVersion string                           `json:"template_version"`
+	Language        string                           `json:"language"`
+	Overview        *stock.GetMarketOverviewResponse `json:"overview"` // 生成时使用的市场数据
+	GeneratedAt     time.Time                        `json:"generated_at"`
+	ExpiresAt       time.Time                        `json:"expires_at"`
+	Cached          bool                             `json:"cached,omitempty"` // 来自本时段缓存或与进行中的生成合并
+}
+
+// MarketPhase 返回 t 所处的市场时段、所属交易日与时段结束时间（均为香港时间）。不识别公众假期，假期按交易日处理。
+func MarketPhase(t time.Time) (phase string, session time.Time, end time.Time) {
+	t = t.In(hkLocation())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	at := func(h, m int) time.Time { return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	switch t.Weekday() {
+	case time.Saturday:
+		return PhaseClosed, day.AddDate(0, 0, -1), day.AddDate(0, 0, 2)
+	case time.Sunday:
+		return PhaseClosed, day.AddDate(0, 0, -2), day.AddDate(0, 0, 1)
+	}
+	switch {
+	case t.Before(at(9, 30)):
+		return PhasePreOpen, day, at(9, 30)
+	case t.Before(at(12, 0)):
+		return PhaseMorning, day, at(12, 0)
+	case t.Before(at(13, 0)):
+		return PhaseLunch, day, at(13, 0)
+	case t.Before(at(16, 0)):
+		return PhaseAfternoon, day, at(16, 0)
+	}
+	return PhaseClosed, day, day.AddDate(0, 0, 1)
+}
+
+// hkLocation 香港时区，系统缺少时区数据时使用固定 UTC+8
+func hkLocation() *time.Location {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		return time.FixedZone("HKT", 8*3600)
+	}
+	return loc
+}
+
+// MarketCommentary 非流式获取当前时段的市场评论
+func (p *Predictor) MarketCommentary(ctx context.Context, req MarketCommentaryRequest) (*MarketCommentary, error) {
+	return p.StreamMarketCommentary(ctx, req, nil)
+}
+
+// StreamMarketCommentary 获取当前时段的市场评论：命中缓存时重放事件，否则加入或发起本时段的生成。
+// 流式时依次发送 overview、reasoning/content 增量与 commentary 事件；emit 为 nil 时为非流式。
+func (p *Predictor) StreamMarketCommentary(ctx context.Context, req MarketCommentaryRequest, emit EventFunc) (*MarketCommentary, error) {
+	lang, err := i18n.Normalize(req.Language)
+	if err != nil {
+		return nil, err
+	}
+	phase, session, end := MarketPhase(time.Now())
+	key := strings.Join([]string{"commentary", session.Format("2006-01-02"), phase, lang}, "|")
+	// 生成与请求解绑，访客断开后仍完成并写入缓存，到时段结束时过期
+	gen := func(ctx context.Context, emit EventFunc) (interface{}, time.Time, error) {
+		res, err := p.generateCommentary(ctx, lang, phase, session, end, emit)
+		return res, end, err
+	}
+	v, cached, err := p.cache.do(ctx, key, req.ForceRefresh, true, gen, emit)
+	if err != nil {
+		return nil, err
+	}
+	res := *v.(*MarketCommentary) // 结果与缓存共享，返回副本
+	res.Cached = cached
+	return &res, emitCommentary(emit, &res)
+}
+
+// emitCommentary 流式时发送最终的 commentary 事件（含 Cached 标记，故不随生成事件缓存）
+func emitCommentary(emit EventFunc, res *MarketCommentary) error {
+	if emit == nil {
+		return nil
+	}
+	return emit(Event{Type: EventCommentary, Data: res})
+}
+
+// generateCommentary 拉取市场概览并调用默认模型生成评论
+func (p *Predictor) generateCommentary(ctx context.Context, lang, phase string, session, end time.Time, emit EventFunc) (*MarketCommentary, error) {
+	if !p.llm.Configured() {
+		return nil, i18n.Errorf(i18n.MsgLLMNotConfigured)
+	}
+	model, err := p.usage.Admit(p.llm.DefaultModel())
+	if err != nil {
+		return nil, err
+	}
+	overview, err := p.stockClient.GetMarketOverview(ctx, &stock.GetMarketOverviewRequest{TopN: commentaryTopN})
+	if err != nil {
+		return nil, fmt.Errorf("获取市场概览失败: %w", err)
+	}
+	if overview == nil {
+		return nil, fmt.Errorf("获取市场概览失败: 返回为空")
+	}
+	if err := emit(Event{Type: EventOverview, Data: overview}); err != nil {
+		return nil, err
+	}
+	log.Printf("[Commentary] generate session=%s phase=%s lang=%s model=%s", session.Format("2006-01-02"), phase, lang, model)
+
+	market := indicesText(overview.Indices)
+	if market == "" {
+		market = "获取失败"
+	}
+	now := time.Now()
+	data := promptData{
+		Now:           now.In(hkLocation()).Format("2006-01-02 15:04:05"),
+		TradingStatus: phaseNames[lang][phase],
+		Market:        market,
+		Overview:      overviewText(overview),
+		Language:      lang,
+	}
+	resp, _, version, err := p.ask(ctx, model, commentaryTemplateID, "", data, false, emit)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(resp.Content)
+	if text == "" {
+		return nil, i18n.Errorf(i18n.MsgEmptyAnswer)
+	}
+	res := &MarketCommentary{
+		SessionDate: session.Format("2006-01-02"), Phase: phase, Commentary: text,
+		Model: model, TemplateVersion: version, Language: lang, Overview: overview,
+		GeneratedAt: now, ExpiresAt: end,
+	}
+	return res, nil
+}
+
+// overviewText [市场宽度]、涨跌幅与主力资金排行、[南向资金] 文本；获取失败的部分注明原因
+func overviewText(o *stock.GetMarketOverviewResponse) string {
+	var b strings.Builder
+	if br := o.Breadth; br != nil {
+		total := br.Advancers + br.Decliners + br.Unchanged
+		fmt.Fprintf(&b, "[市场宽度]\n上涨 %d 家，下跌 %d 家，平盘 %d 家", br.Advancers, br.Decliners, br.Unchanged)
+		if total > 0 {
+			fmt.Fprintf(&b, "（上涨占比 %.0f%%）", float64(br.Advancers)/float64(total)*100)
+		}
+		b.WriteString("\n")
+		movers := func(title string, list []*stock.MarketMover, flow bool) {
+			fmt.Fprintf(&b, "\n[%s]\n", title)
+			if len(list) == 0 {
+				b.WriteString("无数据\n")
+			}
+			for _, m := range list {
+				if flow {
+					fmt.Fprintf(&b, "- %s（%s）%.3f 港元，%+.2f%%，主力净流入 %.2f 亿港元\n", m.Name, m.Code, m.Price, m.ChangePercent, m.MainNetInflow/1e8)
+				} else {
+					fmt.Fprintf(&b, "- %s（%s）%.3f 港元，%+.2f%%\n", m.Name, m.Code, m.Price, m.ChangePercent)
+				}
+			}
+		}
+		movers("涨幅榜", o.Gainers, false)
+		movers("跌幅榜", o.Losers, false)
+		movers("主力净流入前列", o.InflowLeaders, true)
+		movers("主力净流出前列", o.OutflowLeaders, true)
+	} else {
+		b.WriteString("[市场宽度]\n获取失败\n")
+	}
+	b.WriteString("\n[南向资金]\n")
+	if len(o.Southbound) == 0 {
+		b.WriteString("获取失败\n")
+	}
+	var net float64
+	for _, s := range o.Southbound {
+		net += s.NetBuy
+		fmt.Fprintf(&b, "- %s 净买入 %.2f 亿元人民币，当日额度余额 %.2f 亿元\n", s.Channel, s.NetBuy, s.QuotaRemain)
+	}
+	if len(o.Southbound) > 1 {
+		fmt.Fprintf(&b, "- 合计净买入 %.2f 亿元人民币\n", net)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
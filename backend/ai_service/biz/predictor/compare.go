@@ -0,0 +1,296 @@
+package predictor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+)
+
+// 多股对比一次的股票数
+const (
+	MinCompareCodes = 2
+	MaxCompareCodes = 5
+)
+
+// compareTemplateID 多股对比使用的模板 id
+const compareTemplateID = "compare"
+
+// ModeCompare 多股对比产生的预测记录的模式
+const ModeCompare = "compare"
+
+// CompareRequest 多股对比请求：同一周期、同一模型对 2～5 只股票横向比较
+type CompareRequest struct {
+	Codes           []string `json:"codes"`
+	Days            int32    `json:"days"` // <=0 时取 3
+	Model           string   `json:"model,omitempty"`
+	TemplateVersion string   `json:"template_version,omitempty"`
+	Language        string   `json:"language,omitempty"`
+}
+
+// CompareStock 对比中的一只股票：排名、结构化结论与理由，以及所用的行情与技术面
+type CompareStock struct {
+	Code          string        `json:"code"`
+	Name          string        `json:"name,omitempty"`
+	Price         float64       `json:"price"`
+	ChangePercent float64       `json:"change_percent"`
+	Rank          int           `json:"rank"`              // 1 为最看好；模型未给出该股排名时为 0，排在最后
+	Verdict       *Verdict      `json:"verdict,omitempty"` // 置信度已按历史命中率校准
+	Reason        string        `json:"reason,omitempty"`  // 排名理由（一句话）
+	Technical     *Technical    `json:"technical,omitempty"`
+	Bands         *quant.Bands  `json:"bands,omitempty"`
+	PredictionID  string        `json:"prediction_id,omitempty"` // 有结论时写入的预测记录 ID
+	Context       *DataSnapshot `json:"context,omitempty"`       // 该股的输入数据与各项获取情况
+}
+
+// CompareResult 多股对比结果；Stocks 按排名升序
+type CompareResult struct {
+	Days            int32          `json:"days"`
+	Model           string         `json:"model"`
+	TemplateVersion string         `json:"template_version"`
+	Language        string         `json:"language"`
+	Analysis        string         `json:"analysis"` // 对比分析正文（已去掉末尾 JSON）
+	Stocks          []CompareStock `json:"stocks"`
+	Warnings        []Warning      `json:"warnings,omitempty"` // 各股输入数据缺失与结论区间的核对告警，Message 以股票代码开头
+}
+
+// compareRanking 模型在回答末尾给出的排名 JSON
+type compareRanking struct {
+	Ranking []struct {
+		Code   string `json:"code"`
+		Rank   int    `json:"rank"`
+		Reason string `json:"reason"`
+		Verdict
+	} `json:"ranking"`
+}
+
+var (
+	// rankingFenceRe 回答末尾含 ranking 的 ```json {...} ``` 代码块
+	rankingFenceRe = regexp.MustCompile("(?s)```(?:json)?\\s*(\\{[^`]*\"ranking\"[^`]*\\})\\s*```")
+	// rankingBareRe 未加代码块时，从 {"ranking" 起到最后一个 } 的 JSON
+	rankingBareRe = regexp.MustCompile(`(?s)\{\s*"ranking"\s*:.*\}`)
+)
+
+// Compare 并发采集各股数据快照，由 LLM 横向比较并给出排名与各股结构化结论；各股结论按模板 compare 的版本校准置信度并写入预测记录。
+func (p *Predictor) Compare(ctx context.Context, req CompareRequest) (*CompareResult, error) {
+	req, codes, err := p.prepareCompare(req)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[Compare] start codes=%v days=%d model=%s", codes, req.Days, req.Model)
+
+	snaps := make([]*Snapshot, len(codes))
+	var wg sync.WaitGroup
+	for i, code := range codes {
+		wg.Add(1)
+		go func(i int, code string) {
+			defer wg.Done()
+			snaps[i] = p.gatherContext(ctx, code, req.Days)
+		}(i, code)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, snap := range snaps {
+		if err := snap.checkCritical(); err != nil {
+			return nil, err
+		}
+	}
+
+	data := newPromptData(snaps[0], req.Language)
+	data.Code = strings.Join(codes, "、")
+	data.Stocks = compareStocksText(snaps, req.Language)
+	resp, _, template, err := p.ask(ctx, req.Model, compareTemplateID, req.TemplateVersion, data, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(resp.Content)
+	if text == "" {
+		text = strings.TrimSpace(resp.Reasoning)
+	}
+	if text == "" {
+		return nil, i18n.Errorf(i18n.MsgEmptyAnswer)
+	}
+	ranking, analysis := parseRanking(text)
+
+	res := &CompareResult{Days: req.Days, Model: resp.Model, TemplateVersion: template, Language: req.Language, Analysis: analysis}
+	if res.Model == "" {
+		res.Model = req.Model
+	}
+	for i, snap := range snaps {
+		st := CompareStock{Code: codes[i], Technical: snap.Technical, Bands: snap.Bands, Context: snap.Data()}
+		for _, w := range snap.dataWarnings(req.Language) {
+			res.Warnings = append(res.Warnings, Warning{Kind: w.Kind, Message: codes[i] + "：" + w.Message})
+		}
+		if q := snap.Quote; q != nil {
+			st.Name, st.Price, st.ChangePercent = q.Name, q.CurrentPrice, q.ChangePercent
+		}
+		if r, ok := ranking[normalizeCompareCode(codes[i])]; ok {
+			st.Rank, st.Reason = r.rank, r.reason
+			if r.verdict != nil {
+				st.Verdict = r.verdict
+				for _, w := range verifyRange(snap, r.verdict, st.Price, req.Language) {
+					res.Warnings = append(res.Warnings, Warning{Kind: WarningRange, Message: codes[i] + "：" + w})
+				}
+				st.PredictionID = p.recordCompare(snap, res, st.Verdict)
+			}
+		}
+		res.Stocks = append(res.Stocks, st)
+	}
+	rankStocks(res.Stocks)
+	return res, nil
+}
+
+// prepareCompare 校验代码数量（按数字部分去重后 2～5 只）、补全周期与模型并按预算确认模型；规则量化模型不能做对比分析。
+func (p *Predictor) prepareCompare(req CompareRequest) (CompareRequest, []string, error) {
+	if err := CheckDays(req.Days); err != nil {
+		return req, nil, err
+	}
+	if req.Days <= 0 {
+		req.Days = 3
+	}
+	lang, err := i18n.Normalize(req.Language)
+	if err != nil {
+		return req, nil, err
+	}
+	req.Language = lang
+	var codes []string
+	seen := map[string]bool{}
+	for _, c := range dedupCodes(req.Codes) {
+		key := normalizeCompareCode(c)
+		if key == "" {
+			key = strings.ToLower(c)
+		}
+		if !seen[key] { // hk00700 与 700 视为同一只
+			seen[key] = true
+			codes = append(codes, c)
+		}
+	}
+	if len(codes) < MinCompareCodes || len(codes) > MaxCompareCodes {
+		return req, nil, i18n.Errorf(i18n.MsgCompareCodes, MinCompareCodes, MaxCompareCodes, len(codes))
+	}
+	if req.Model == quant.ModelName {
+		return req, nil, i18n.Errorf(i18n.MsgCompareQuant)
+	}
+	if !p.llm.Configured() {
+		return req, nil, i18n.Errorf(i18n.MsgLLMNotConfigured)
+	}
+	if req.Model == "" {
+		req.Model = p.llm.DefaultModel()
+	}
+	if req.Model, err = p.usage.Admit(req.Model); err != nil {
+		return req, nil, err
+	}
+	return req, codes, nil
+}
+
+// compareStocksText 各股的数据块，依次为实时数据、技术面与统计区间（统计区间按 lang）
+func compareStocksText(snaps []*Snapshot, lang string) string {
+	blocks := make([]string, 0, len(snaps))
+	for _, s := range snaps {
+		title := s.Code
+		if s.Quote != nil && s.Quote.Name != "" {
+			title += " " + s.Quote.Name
+		}
+		blocks = append(blocks, fmt.Sprintf("### %s\n[个股实时数据]\n%s\n\n[技术面]\n%s\n\n[统计区间]\n%s",
+			title, s.Stock, s.TechnicalText, s.bandsText(lang)))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// rankedStock 解析出的一只股票的排名与结论
+type rankedStock struct {
+	rank    int
+	reason  string
+	verdict *Verdict // 方向无法识别时为 nil
+}
+
+// parseRanking 从回答末尾提取排名 JSON，返回 (规范化代码 → 排名与结论, 去掉 JSON 块后的正文)。未找到时排名为空、正文原样返回。
+func parseRanking(text string) (map[string]rankedStock, string) {
+	raw, loc := "", []int(nil)
+	if m := rankingFenceRe.FindAllStringSubmatchIndex(text, -1); len(m) > 0 {
+		last := m[len(m)-1]
+		raw, loc = text[last[2]:last[3]], last[:2]
+	} else if m := rankingBareRe.FindStringIndex(text); m != nil {
+		raw, loc = text[m[0]:m[1]], m
+	}
+	out := map[string]rankedStock{}
+	if raw == "" {
+		return out, text
+	}
+	var r compareRanking
+	if err := json.Unmarshal([]byte(raw), &r); err != nil {
+		return out, text
+	}
+	for _, item := range r.Ranking {
+		code := normalizeCompareCode(item.Code)
+		if code == "" {
+			continue
+		}
+		rs := rankedStock{rank: item.Rank, reason: strings.TrimSpace(item.Reason)}
+		v := item.Verdict
+		if v.Direction = NormalizeDirection(v.Direction); v.Direction != "" {
+			if v.Confidence < 0 || v.Confidence > 1 {
+				v.Confidence = 0
+			}
+			rs.verdict = &v
+		}
+		out[code] = rs
+	}
+	return out, strings.TrimSpace(text[:loc[0]] + text[loc[1]:])
+}
+
+// normalizeCompareCode 取代码中的数字并补足 5 位（hk00700、00700.HK、700 均为 00700），用于匹配模型输出的代码
+func normalizeCompareCode(code string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, code)
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" || len(digits) > 5 {
+		return ""
+	}
+	return strings.Repeat("0", 5-len(digits)) + digits
+}
+
+// rankStocks 按模型给出的名次排序并重新编号为 1..n（并列或跳号时保持原顺序），未排名的排在最后、Rank 为 0
+func rankStocks(stocks []CompareStock) {
+	sort.SliceStable(stocks, func(i, j int) bool {
+		a, b := stocks[i].Rank, stocks[j].Rank
+		if (a > 0) != (b > 0) {
+			return a > 0
+		}
+		return a < b
+	})
+	for i := range stocks {
+		if stocks[i].Rank > 0 {
+			stocks[i].Rank = i + 1
+		}
+	}
+}
+
+// recordCompare 校准该股结论的置信度并写入预测记录（模式 compare，详情中的分析为完整对比正文），返回记录 ID
+func (p *Predictor) recordCompare(snap *Snapshot, cr *CompareResult, v *Verdict) string {
+	res := &Result{
+		Code:            snap.Code,
+		Model:           cr.Model,
+		Mode:            ModeCompare,
+		TemplateVersion: cr.TemplateVersion,
+		Analysis:        cr.Analysis,
+		Confidence:      v.Confidence,
+		Verdict:         v,
+	}
+	p.calibrate(res)
+	p.record(snap, res)
+	return res.ID
+}
@@ -0,0 +1,125 @@
+package predictor
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNormalizeCompareCode(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"hk00700", "00700"},
+		{"HK00700", "00700"},
+		{"00700.HK", "00700"},
+		{"0700.HK", "00700"},
+		{"700", "00700"},
+		{" 9988 ", "09988"},
+		{"hk09988", "09988"},
+		{"01810", "01810"},
+		{"hk00000", ""},
+		{"腾讯", ""},
+		{"", ""},
+		{"1234567", ""},
+	} {
+		if got := normalizeCompareCode(tt.in); got != tt.want {
+			t.Errorf("normalizeCompareCode(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseRanking(t *testing.T) {
+	const body = "腾讯与阿里对比分析。"
+	for _, tt := range []struct {
+		name, text string
+		want       string // 规范化代码:名次:方向，按代码排序
+		body       string
+	}{
+		{
+			"fenced",
+			body + "\n```json\n{\"ranking\":[{\"code\":\"hk00700\",\"rank\":1,\"reason\":\" 估值低 \",\"direction\":\"up\",\"confidence\":0.7},{\"code\":\"09988.HK\",\"rank\":2,\"direction\":\"看空\"}]}\n```",
+			"00700:1:bullish 09988:2:bearish", body,
+		},
+		{
+			"fence without language tag",
+			body + "\n```\n{\"ranking\":[{\"code\":\"700\",\"rank\":1,\"direction\":\"neutral\"}]}\n```",
+			"00700:1:neutral", body,
+		},
+		{
+			"last fenced block wins",
+			body + "\n```json\n{\"ranking\":[{\"code\":\"700\",\"rank\":2}]}\n```\n修正如下\n```json\n{\"ranking\":[{\"code\":\"700\",\"rank\":1}]}\n```",
+			"00700:1:", body + "\n```json\n{\"ranking\":[{\"code\":\"700\",\"rank\":2}]}\n```\n修正如下",
+		},
+		{
+			"bare",
+			body + "\n{\"ranking\": [{\"code\": \"hk03690\", \"rank\": 1, \"direction\": \"bearish\"}]}",
+			"03690:1:bearish", body,
+		},
+		{
+			"unknown direction and code skipped",
+			body + "\n```json\n{\"ranking\":[{\"code\":\"hk00700\",\"rank\":1,\"direction\":\"maybe\"},{\"code\":\"腾讯\",\"rank\":2}]}\n```",
+			"00700:1:", body,
+		},
+		{"no json", body, "", body},
+		{"invalid json", body + "\n```json\n{\"ranking\": [ }\n```", "", body + "\n```json\n{\"ranking\": [ }\n```"},
+		{"verdict json is not a ranking", body + "\n```json\n{\"direction\":\"up\"}\n```", "", body + "\n```json\n{\"direction\":\"up\"}\n```"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ranking, rest := parseRanking(tt.text)
+			var got []string
+			for _, code := range []string{"00700", "03690", "09988"} {
+				r, ok := ranking[code]
+				if !ok {
+					continue
+				}
+				dir := ""
+				if r.verdict != nil {
+					dir = r.verdict.Direction
+				}
+				got = append(got, fmt.Sprintf("%s:%d:%s", code, r.rank, dir))
+			}
+			if len(got) != len(ranking) {
+				t.Errorf("ranking = %+v", ranking)
+			}
+			if s := fmt.Sprint(got); s != "["+tt.want+"]" {
+				t.Errorf("ranking = %s, want [%s]", s, tt.want)
+			}
+			if rest != tt.body {
+				t.Errorf("body = %q, want %q", rest, tt.body)
+			}
+		})
+	}
+
+	ranking, _ := parseRanking("```json\n{\"ranking\":[{\"code\":\"700\",\"rank\":1,\"reason\":\" 估值低 \",\"direction\":\"up\",\"confidence\":1.5}]}\n```")
+	if r := ranking["00700"]; r.reason != "估值低" || r.verdict == nil || r.verdict.Confidence != 0 {
+		t.Errorf("ranked = %+v, want trimmed reason and out-of-range confidence reset", r)
+	}
+}
+
+func TestRankStocks(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		ranks []int  // 输入顺序的名次，代码依次为 a、b、c…
+		want  string // 排序后的 代码:名次
+	}{
+		{"ordered", []int{1, 2, 3}, "[a:1 b:2 c:3]"},
+		{"reversed", []int{3, 2, 1}, "[c:1 b:2 a:3]"},
+		{"gaps renumbered", []int{5, 1, 9}, "[b:1 a:2 c:3]"},
+		{"ties keep input order", []int{2, 1, 2}, "[b:1 a:2 c:3]"},
+		{"unranked last", []int{0, 2, 0, 1}, "[d:1 b:2 a:0 c:0]"},
+		{"none ranked", []int{0, 0}, "[a:0 b:0]"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stocks := make([]CompareStock, len(tt.ranks))
+			for i, r := range tt.ranks {
+				stocks[i] = CompareStock{Code: string(rune('a' + i)), Rank: r}
+			}
+			rankStocks(stocks)
+			var got []string
+			for _, s := range stocks {
+				got = append(got, fmt.Sprintf("%s:%d", s.Code, s.Rank))
+			}
+			if s := fmt.Sprint(got); s != tt.want {
+				t.Errorf("ranked = %s, want %s", s, tt.want)
+			}
+		})
+	}
+}
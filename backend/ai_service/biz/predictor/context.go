@@ -0,0 +1,212 @@
+package predictor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Snapshot 一次预测所用的输入数据；结构化数据与渲染进 prompt 的文本块一并保存。
+// 新增数据源只需在 gatherContext 中采集并在模板中引用。
+type Snapshot struct {
+	Code      string
+	Days      int32
+	Time      time.Time
+	IsTrading bool
+
+	Quote   *stock.StockInfo     // 个股实时行情，失败时为 nil
+	Indices []*stock.MarketIndex // 大盘指数
+
+	Stock         string         // [个股实时数据] 文本
+	Market        string         // [大盘指数] 文本
+	Bars          []*stock.KLine // 近期日 K（升序），获取失败时为空
+	Technical     *Technical
+	TechnicalText string       // [技术面] 文本
+	Bands         *quant.Bands // 蒙特卡洛价格分位带，日 K 不足时为 nil
+	BandsText     string       // [统计区间] 文本
+
+	Historical bool           // 回测用的历史快照（见 historicalSnapshot）
+	IndexBars  []*stock.KLine // 历史快照截至当日的恒指日 K
+
+	Sources []DataSource // 各项数据的获取情况（历史快照为空）
+}
+
+// 输入数据项
+const (
+	SourceQuote  = "quote"  // 个股实时行情：关键数据，获取失败时拒绝预测
+	SourceMarket = "market" // 大盘指数
+	SourceKline  = "kline"  // 日 K（技术指标与统计区间的来源）
+)
+
+// DataSource 一项输入数据的获取情况
+type DataSource struct {
+	Name      string    `json:"name"`
+	OK        bool      `json:"ok"`
+	Critical  bool      `json:"critical,omitempty"` // 获取失败时拒绝预测
+	Error     string    `json:"error,omitempty"`
+	FetchedAt time.Time `json:"fetched_at"`
+	ElapsedMs int64     `json:"elapsed_ms"`
+}
+
+// DataSnapshot 模型实际看到的结构化输入：随结果返回（Result.Context），流式时作为 context 事件最先发送。
+// 技术指标与统计区间见 Result.Technical/Bands，模型通过工具额外获取的数据见 Result.ToolCalls。
+type DataSnapshot struct {
+	Code        string               `json:"code"`
+	Time        time.Time            `json:"time"` // 开始采集的时间
+	IsTrading   bool                 `json:"is_trading"`
+	Quote       *stock.StockInfo     `json:"quote,omitempty"`
+	Indices     []*stock.MarketIndex `json:"indices,omitempty"`
+	Bars        int                  `json:"bars"`                    // 日 K 根数
+	LastBarDate string               `json:"last_bar_date,omitempty"` // 最后一根日 K 的日期
+	Sources     []DataSource         `json:"sources"`
+}
+
+// gatherContext 预拉取个股行情、大盘指数与日 K 技术指标。单项失败不影响其他项，失败原因写入对应文本块并记入 Sources。
+func (p *Predictor) gatherContext(ctx context.Context, code string, days int32) *Snapshot {
+	snap := &Snapshot{Code: code, Days: days, Time: time.Now(), IsTrading: IsHKTradingTime()}
+	track := func(name string, critical bool, fetch func() error) {
+		start := time.Now()
+		err := fetch()
+		src := DataSource{Name: name, OK: err == nil, Critical: critical, FetchedAt: time.Now(), ElapsedMs: time.Since(start).Milliseconds()}
+		if err != nil {
+			src.Error = err.Error()
+		}
+		snap.Sources = append(snap.Sources, src)
+	}
+	track(SourceQuote, true, func() (err error) {
+		snap.Quote, snap.Stock, err = p.fetchStockData(ctx, code)
+		return err
+	})
+	track(SourceMarket, false, func() (err error) {
+		snap.Indices, snap.Market, err = p.fetchMarketData(ctx)
+		return err
+	})
+	track(SourceKline, false, func() (err error) {
+		snap.Bars, snap.Technical, snap.TechnicalText, err = p.fetchTechnical(ctx, code)
+		return err
+	})
+	snap.Bands, snap.BandsText = simulateBands(snap)
+	return snap
+}
+
+// Data 快照的结构化形式
+func (s *Snapshot) Data() *DataSnapshot {
+	d := &DataSnapshot{Code: s.Code, Time: s.Time, IsTrading: s.IsTrading, Quote: s.Quote, Indices: s.Indices, Bars: len(s.Bars), Sources: s.Sources}
+	if n := len(s.Bars); n > 0 {
+		d.LastBarDate = s.Bars[n-1].Date
+	}
+	if d.Sources == nil {
+		d.Sources = []DataSource{}
+	}
+	return d
+}
+
+// checkCritical 关键数据（个股行情）获取失败时返回错误，此时不应继续预测
+func (s *Snapshot) checkCritical() error {
+	for _, src := range s.Sources {
+		if src.Critical && !src.OK {
+			return i18n.Errorf(i18n.MsgDataQuoteFailed, s.Code, src.Error)
+		}
+	}
+	return nil
+}
+
+// dataWarnings 非关键数据获取失败的告警：分析未参考该项数据
+func (s *Snapshot) dataWarnings(lang string) []Warning {
+	var ws []Warning
+	for _, src := range s.Sources {
+		if src.OK {
+			continue
+		}
+		var key string
+		switch src.Name {
+		case SourceMarket:
+			key = i18n.MsgDataMarketFailed
+		case SourceKline:
+			key = i18n.MsgDataKlineFailed
+		default:
+			continue
+		}
+		ws = append(ws, Warning{Kind: WarningData, Message: i18n.T(lang, key, src.Error)})
+	}
+	return ws
+}
+
+// simulateBands 由日 K 模拟预测周期内的价格分位带（起始价取现价），返回 (分位带, prompt 文本)
+func simulateBands(snap *Snapshot) (*quant.Bands, string) {
+	if len(snap.Bars) == 0 {
+		return nil, "无K线数据，无法模拟"
+	}
+	price := 0.0
+	if snap.Quote != nil {
+		price = snap.Quote.CurrentPrice
+	}
+	b, err := quant.Simulate(snap.Bars, int(snap.Days), price, quant.MCConfigFromEnv())
+	if err != nil {
+		return nil, err.Error()
+	}
+	return b, b.Text(i18n.ZhCN)
+}
+
+// bandsText 按 lang 输出的 [统计区间] 文本；未能模拟时为原因说明
+func (s *Snapshot) bandsText(lang string) string {
+	if s.Bands != nil {
+		return s.Bands.Text(lang)
+	}
+	return s.BandsText
+}
+
+// ContextText 数据快照的文本形式（各数据块带标题），用于保存预测详情与追问会话背景。
+func (s *Snapshot) ContextText() string {
+	return fmt.Sprintf("时间：%s\n\n[个股实时数据]\n%s\n\n[大盘指数]\n%s\n\n[技术面]\n%s\n\n[统计区间]\n%s",
+		s.Time.Format("2006-01-02 15:04:05"), s.Stock, s.Market, s.TechnicalText, s.BandsText)
+}
+
+// fetchStockData 预拉取个股实时行情，返回 (行情, prompt 文本, 失败原因)。
+func (p *Predictor) fetchStockData(ctx context.Context, code string) (*stock.StockInfo, string, error) {
+	rpcResp, err := p.stockClient.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: code})
+	if err != nil {
+		return nil, fmt.Sprintf("获取行情失败: %v", err), err
+	}
+	if rpcResp == nil || rpcResp.Stock == nil {
+		return nil, "无行情数据", errors.New("无行情数据")
+	}
+	return rpcResp.Stock, quoteText(rpcResp.Stock), nil
+}
+
+// quoteText [个股实时数据] 文本
+func quoteText(s *stock.StockInfo) string {
+	return fmt.Sprintf("名称=%s, 代码=%s, 现价=%.2f, 涨跌幅=%.2f%%, 成交量=%d",
+		s.Name, s.Code, s.CurrentPrice, s.ChangePercent, s.Volume)
+}
+
+// fetchMarketData 预拉取大盘指数（恒生等），返回 (指数, prompt 文本, 失败原因)。
+func (p *Predictor) fetchMarketData(ctx context.Context) ([]*stock.MarketIndex, string, error) {
+	rpcResp, err := p.stockClient.GetMarketSummary(ctx, &stock.GetMarketSummaryRequest{})
+	if err != nil {
+		return nil, fmt.Sprintf("获取大盘失败: %v", err), err
+	}
+	if rpcResp == nil || len(rpcResp.Indices) == 0 {
+		return nil, "无大盘数据", errors.New("无大盘数据")
+	}
+	return rpcResp.Indices, indicesText(rpcResp.Indices), nil
+}
+
+// indicesText [大盘指数] 文本，每个指数一行
+func indicesText(indices []*stock.MarketIndex) string {
+	var lines []string
+	for _, idx := range indices {
+		if idx == nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %.2f, 涨跌%.2f%%, 变动%.2f",
+			idx.Name, idx.Value, idx.ChangePercent, idx.Change))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,125 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+)
+
+// 预测模式
+const (
+	ModeSingle = "single" // 单次分析（默认）
+	ModeDebate = "debate" // 多空辩论：多方、空方分析师分别论证，裁判给出最终结论
+)
+
+// 辩论角色；流式时各角色的文本增量以 <角色>_reasoning / <角色>_content 事件发送
+const (
+	RoleBull  = "bull"
+	RoleBear  = "bear"
+	RoleJudge = "judge"
+)
+
+// 辩论各角色使用的模板 id
+const (
+	debateBullTemplateID  = "debate_bull"
+	debateBearTemplateID  = "debate_bear"
+	debateJudgeTemplateID = "debate_judge"
+)
+
+// Debate 辩论模式下多空双方的论证；裁判的回答即 Result.Analysis
+type Debate struct {
+	Bull string `json:"bull"`
+	Bear string `json:"bear"`
+}
+
+// RoleEvent 辩论角色的文本事件类型，如 RoleEvent(RoleBull, EventContent) = "bull_content"
+func RoleEvent(role, kind string) string { return role + "_" + kind }
+
+// debate 多空辩论：多方与空方基于同一份数据并发论证（可调用工具），裁判再结合原始数据与双方观点给出结构化结论。
+// 返回裁判的回答、全部工具调用、裁判模板 id@version 与双方论证。
+func (p *Predictor) debate(ctx context.Context, req Request, data promptData, useTools bool, emit EventFunc) (*llm.Response, []ToolInvocation, string, *Debate, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var mu sync.Mutex // 两位分析师并发输出，事件写入需串行
+
+	type side struct {
+		role, templateID string
+		text             string
+		calls            []ToolInvocation
+		err              error
+	}
+	sides := []*side{{role: RoleBull, templateID: debateBullTemplateID}, {role: RoleBear, templateID: debateBearTemplateID}}
+	var wg sync.WaitGroup
+	for _, s := range sides {
+		wg.Add(1)
+		go func(s *side) {
+			defer wg.Done()
+			resp, calls, _, err := p.ask(ctx, req.Model, s.templateID, "", data, useTools, roleEmit(s.role, emit, &mu))
+			s.calls = withRole(s.role, calls)
+			if err != nil {
+				s.err = fmt.Errorf("%s 分析师: %w", s.role, err)
+				cancel()
+				return
+			}
+			s.text = analystText(resp)
+		}(s)
+	}
+	wg.Wait()
+
+	var calls []ToolInvocation
+	for _, s := range sides {
+		if s.err != nil {
+			return nil, nil, "", nil, s.err
+		}
+		calls = append(calls, s.calls...)
+	}
+	debate := &Debate{Bull: sides[0].text, Bear: sides[1].text}
+
+	data.Bull, data.Bear = debate.Bull, debate.Bear
+	resp, _, template, err := p.ask(ctx, req.Model, debateJudgeTemplateID, "", data, false, roleEmit(RoleJudge, emit, &mu))
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("%s: %w", RoleJudge, err)
+	}
+	return resp, calls, template, debate, nil
+}
+
+// roleEmit 将文本增量改为带角色前缀的事件类型，工具调用记录标注角色；emit 为 nil 时返回 nil（非流式）。
+func roleEmit(role string, emit EventFunc, mu *sync.Mutex) EventFunc {
+	if emit == nil {
+		return nil
+	}
+	return func(ev Event) error {
+		switch ev.Type {
+		case EventReasoning, EventContent:
+			ev.Type = RoleEvent(role, ev.Type)
+		case EventTool:
+			if inv, ok := ev.Data.(ToolInvocation); ok {
+				inv.Role = role
+				ev.Data = inv
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return emit(ev)
+	}
+}
+
+func withRole(role string, calls []ToolInvocation) []ToolInvocation {
+	for i := range calls {
+		calls[i].Role = role
+	}
+	return calls
+}
+
+// analystText 分析师的论证正文：content 为空时退回推理内容，并去掉误输出的 JSON 结论块
+func analystText(resp *llm.Response) string {
+	text := strings.TrimSpace(resp.Content)
+	if text == "" {
+		text = strings.TrimSpace(resp.Reasoning)
+	}
+	_, text = parseVerdict(text)
+	return text
+}
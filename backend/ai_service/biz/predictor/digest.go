@@ -0,0 +1,254 @@
+package predictor
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+)
+
+// digestTop 最高置信度榜单的条数
+const digestTop = 3
+
+// wideRangePct 预计涨跌幅区间宽度超过该值（百分点）视为不确定性高
+const wideRangePct = 8.0
+
+// DigestEntry 摘要中的一只股票
+type DigestEntry struct {
+	Code          string  `json:"code"`
+	Direction     string  `json:"direction"`
+	Confidence    float64 `json:"confidence"`
+	ChangeLowPct  float64 `json:"change_low_pct"`
+	ChangeHighPct float64 `json:"change_high_pct"`
+	Strength      float64 `json:"strength"` // 置信度 × 预计涨跌幅中枢的绝对值，用于同方向内排序
+	Summary       string  `json:"summary"`  // 分析首句
+	PredictionID  string  `json:"prediction_id,omitempty"`
+}
+
+// DigestRisk 需要留意的风险
+type DigestRisk struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// DigestFailure 预测失败的股票
+type DigestFailure struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+// Digest 批量预测汇总：按方向分组并排序，另列最高置信度、风险提示与失败项，Document 为完整 Markdown 文档。
+type Digest struct {
+	CreatedAt     time.Time       `json:"created_at"`
+	Days          int32           `json:"days"`
+	Total         int             `json:"total"`
+	Bullish       []DigestEntry   `json:"bullish"` // 按 Strength 从强到弱
+	Bearish       []DigestEntry   `json:"bearish"`
+	Neutral       []DigestEntry   `json:"neutral"`
+	Unrated       []DigestEntry   `json:"unrated"` // 未解析出结构化结论
+	TopConfidence []DigestEntry   `json:"top_confidence"`
+	Risks         []DigestRisk    `json:"risks"`
+	Failed        []DigestFailure `json:"failed"`
+	Items         []BatchItem     `json:"items"` // 各股完整结果，顺序同请求
+	Document      string          `json:"document"`
+}
+
+// BuildDigest 由批量预测结果生成汇总，文档与风险提示按 req.Language 输出
+func BuildDigest(req BatchRequest, items []BatchItem) *Digest {
+	days := req.Days
+	if days <= 0 {
+		days = 3
+	}
+	d := &Digest{
+		CreatedAt: time.Now(), Days: days, Total: len(items), Items: items,
+		Bullish: []DigestEntry{}, Bearish: []DigestEntry{}, Neutral: []DigestEntry{}, Unrated: []DigestEntry{},
+		TopConfidence: []DigestEntry{}, Risks: []DigestRisk{}, Failed: []DigestFailure{},
+	}
+	var rated []DigestEntry
+	for _, it := range items {
+		if it.Result == nil {
+			d.Failed = append(d.Failed, DigestFailure{Code: it.Code, Error: it.Error})
+			continue
+		}
+		e := digestEntry(it.Result)
+		switch e.Direction {
+		case DirectionBullish:
+			d.Bullish = append(d.Bullish, e)
+		case DirectionBearish:
+			d.Bearish = append(d.Bearish, e)
+		case DirectionNeutral:
+			d.Neutral = append(d.Neutral, e)
+		default:
+			d.Unrated = append(d.Unrated, e)
+		}
+		if e.Direction != "" {
+			rated = append(rated, e)
+		}
+		d.Risks = append(d.Risks, digestRisks(it.Result, req.Language)...)
+	}
+	for _, list := range [][]DigestEntry{d.Bullish, d.Bearish, d.Neutral} {
+		sort.SliceStable(list, func(a, b int) bool {
+			if list[a].Strength != list[b].Strength {
+				return list[a].Strength > list[b].Strength
+			}
+			return list[a].Confidence > list[b].Confidence
+		})
+	}
+	sort.SliceStable(rated, func(a, b int) bool { return rated[a].Confidence > rated[b].Confidence })
+	if len(rated) > digestTop {
+		rated = rated[:digestTop]
+	}
+	d.TopConfidence = append(d.TopConfidence, rated...)
+	d.Document = d.markdown(req.Language)
+	return d
+}
+
+func digestEntry(res *Result) DigestEntry {
+	e := DigestEntry{Code: res.Code, Confidence: res.Confidence, Summary: firstSentence(res.Analysis, 80), PredictionID: res.ID}
+	if v := res.Verdict; v != nil {
+		e.Direction = v.Direction
+		e.ChangeLowPct, e.ChangeHighPct = v.ChangeLowPct, v.ChangeHighPct
+		mid := math.Abs(v.ChangeLowPct+v.ChangeHighPct) / 2
+		e.Strength = math.Round(v.Confidence*mid*100) / 100
+	}
+	return e
+}
+
+// digestRisks 从结论、技术面与分析正文中提取值得留意的风险
+func digestRisks(res *Result, lang string) []DigestRisk {
+	var risks []DigestRisk
+	add := func(key string, args ...interface{}) {
+		risks = append(risks, DigestRisk{Code: res.Code, Reason: i18n.T(lang, key, args...)})
+	}
+	if v := res.Verdict; v != nil {
+		if v.Direction == DirectionBearish && v.Confidence >= 0.7 {
+			add(i18n.MsgRiskBearish, v.Confidence)
+		}
+		if w := v.ChangeHighPct - v.ChangeLowPct; w >= wideRangePct {
+			add(i18n.MsgRiskWideRange, v.ChangeLowPct, v.ChangeHighPct)
+		}
+	}
+	if t := res.Technical; t != nil {
+		switch {
+		case t.RSI6 >= 80:
+			add(i18n.MsgRiskOverbought, t.RSI6)
+		case t.RSI6 > 0 && t.RSI6 <= 20:
+			add(i18n.MsgRiskOversold, t.RSI6)
+		}
+		if t.VolumeRatio >= 3 {
+			add(i18n.MsgRiskVolume, t.VolumeRatio)
+		}
+	}
+	if s := sentenceContaining(res.Analysis, i18n.T(lang, i18n.MsgRiskKeyword), 80); s != "" {
+		risks = append(risks, DigestRisk{Code: res.Code, Reason: s})
+	}
+	return risks
+}
+
+// markdown 汇总文档
+func (d *Digest) markdown(lang string) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(lang, i18n.MsgDigestTitle, d.CreatedAt.Format("2006-01-02 15:04"), d.Days))
+	b.WriteString(i18n.T(lang, i18n.MsgDigestCounts, d.Total, len(d.Bullish), len(d.Bearish), len(d.Neutral)))
+	if len(d.Unrated) > 0 {
+		b.WriteString(i18n.T(lang, i18n.MsgDigestUnratedN, len(d.Unrated)))
+	}
+	if len(d.Failed) > 0 {
+		b.WriteString(i18n.T(lang, i18n.MsgDigestFailedN, len(d.Failed)))
+	}
+	b.WriteString(i18n.T(lang, i18n.MsgFullStop) + "\n")
+
+	section := func(title string, list []DigestEntry) {
+		if len(list) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", i18n.T(lang, title))
+		for _, e := range list {
+			fmt.Fprintf(&b, "- **%s** %s", e.Code, i18n.T(lang, i18n.MsgVerdictSummary, directionLabel(e.Direction, lang), e.Confidence, changeRange(e)))
+			if e.Summary != "" {
+				fmt.Fprintf(&b, " — %s", e.Summary)
+			}
+			b.WriteString("\n")
+		}
+	}
+	section(i18n.MsgDigestBullish, d.Bullish)
+	section(i18n.MsgDigestBearish, d.Bearish)
+	section(i18n.MsgDigestTop, d.TopConfidence)
+	section(i18n.MsgDigestNeutral, d.Neutral)
+	section(i18n.MsgDigestUnrated, d.Unrated)
+
+	if len(d.Risks) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n\n", i18n.T(lang, i18n.MsgDigestRisks))
+		for _, r := range d.Risks {
+			b.WriteString(i18n.T(lang, i18n.MsgNamedItem, r.Code, r.Reason))
+		}
+	}
+	if len(d.Failed) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n\n", i18n.T(lang, i18n.MsgDigestFailed))
+		for _, f := range d.Failed {
+			b.WriteString(i18n.T(lang, i18n.MsgNamedItem, f.Code, f.Error))
+		}
+	}
+	return b.String()
+}
+
+func directionLabel(dir, lang string) string {
+	switch dir {
+	case DirectionBullish:
+		return i18n.T(lang, i18n.MsgDirBullish)
+	case DirectionBearish:
+		return i18n.T(lang, i18n.MsgDirBearish)
+	case DirectionNeutral:
+		return i18n.T(lang, i18n.MsgDirNeutral)
+	}
+	return "—"
+}
+
+func changeRange(e DigestEntry) string {
+	if e.ChangeLowPct == 0 && e.ChangeHighPct == 0 {
+		return "—"
+	}
+	return fmt.Sprintf("%+.1f%%～%+.1f%%", e.ChangeLowPct, e.ChangeHighPct)
+}
+
+// sentenceEnd 中英文句末标点与换行
+const sentenceEnd = "。！？!?\n"
+
+// firstSentence 正文首句（跳过 Markdown 标题与空行），最多 max 个字符
+func firstSentence(text string, max int) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#>-*0123456789. "))
+		if line == "" {
+			continue
+		}
+		if i := strings.IndexAny(line, sentenceEnd); i >= 0 {
+			line = line[:i]
+		}
+		return clip(line, max)
+	}
+	return ""
+}
+
+// sentenceContaining 第一个包含 keyword（不区分大小写）的句子，最多 max 个字符
+func sentenceContaining(text, keyword string, max int) string {
+	keyword = strings.ToLower(keyword)
+	for _, s := range strings.FieldsFunc(text, func(r rune) bool { return strings.ContainsRune(sentenceEnd, r) }) {
+		s = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(s), "#>-*0123456789.：: "))
+		if l := strings.ToLower(s); strings.Contains(l, keyword) && l != keyword {
+			return clip(s, max)
+		}
+	}
+	return ""
+}
+
+// clip 按字符截断
+func clip(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max]) + "…"
+}
@@ -0,0 +1,347 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+)
+
+// EnsembleModel 多模型对比的综合结果使用的模型名，校准与预测记录按此单独统计
+const EnsembleModel = "ensemble"
+
+// maxEnsembleModels 一次对比的模型数上限
+const maxEnsembleModels = 5
+
+// ModelChunk 多模型对比时某个模型的文本增量
+type ModelChunk struct {
+	Model string `json:"model"`
+	Text  string `json:"text"`
+}
+
+// EnsembleMember 参与对比的一个模型：权重取其历史方向命中率（见 biz/calibration），无历史时取其他模型的平均值（均无则为 1）
+type EnsembleMember struct {
+	Model    string  `json:"model"`
+	Weight   float64 `json:"weight"`
+	Accuracy float64 `json:"accuracy,omitempty"` // 历史方向命中率，无足够已评估样本时为 0
+	Samples  int     `json:"samples,omitempty"`  // 历史已评估样本数
+	Result   *Result `json:"result,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// Ensemble 多模型对比结果：各模型的结果与按权重的方向投票
+type Ensemble struct {
+	Members   []*EnsembleMember  `json:"members"`
+	Votes     map[string]float64 `json:"votes"`     // 方向 → 权重之和，未给出结论的模型不计
+	Agreement float64            `json:"agreement"` // 胜出方向的权重占全部投票权重的比例
+}
+
+// prepareEnsemble 规范多模型对比请求：去重、补全默认模型并逐个按预算确认；不足两个模型时退化为单模型预测。
+func (p *Predictor) prepareEnsemble(req Request) (Request, error) {
+	if req.Mode != "" && req.Mode != ModeSingle {
+		return req, i18n.Errorf(i18n.MsgEnsembleMode, ModeSingle)
+	}
+	var models []string
+	seen := map[string]bool{}
+	for _, m := range req.Models {
+		m = strings.TrimSpace(m)
+		if m == "" {
+			m = p.llm.DefaultModel()
+		}
+		if m != quant.ModelName {
+			if !p.llm.Configured() {
+				return req, i18n.Errorf(i18n.MsgEnsembleNoLLM, m)
+			}
+			admitted, err := p.usage.Admit(m)
+			if err != nil {
+				return req, err
+			}
+			m = admitted // 超出预算降级后可能与其他模型重复
+		}
+		if !seen[m] {
+			seen[m] = true
+			models = append(models, m)
+		}
+	}
+	if len(models) > maxEnsembleModels {
+		return req, i18n.Errorf(i18n.MsgEnsembleTooMany, maxEnsembleModels)
+	}
+	req.Models = nil
+	if len(models) < 2 {
+		if len(models) == 1 {
+			req.Model = models[0]
+		}
+		return p.prepare(req)
+	}
+	req.Models, req.Model, req.Mode = models, EnsembleModel, ModeSingle
+	return req, nil
+}
+
+// ensemble 以同一数据快照并发调用各模型（LLM 模型走结果缓存，quant 走规则量化模型），按历史命中率加权投票得出综合结论。
+// 流式时各模型的文本增量以 model_reasoning / model_content 事件发送，完成后发送 model_done；部分模型失败不影响其余模型。
+func (p *Predictor) ensemble(ctx context.Context, req Request, snap *Snapshot, emit EventFunc) (*Result, error) {
+	members := p.ensembleMembers(req.Models)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu      sync.Mutex // 各模型并发输出，事件写入需串行
+		emitErr error
+		wg      sync.WaitGroup
+	)
+	if emit != nil {
+		// 记下首次写入失败（客户端已断开）并取消其余模型；调用方均持有 mu
+		next := emit
+		emit = func(ev Event) error {
+			if emitErr == nil {
+				if emitErr = next(ev); emitErr != nil {
+					cancel()
+				}
+			}
+			return emitErr
+		}
+	}
+	for _, m := range members {
+		wg.Add(1)
+		go func(m *EnsembleMember) {
+			defer wg.Done()
+			memberEmit := modelEmit(m.Model, emit, &mu)
+			var res *Result
+			var err error
+			if m.Model == quant.ModelName {
+				if res, err = p.quantPredict(ctx, req, snap); err == nil && memberEmit != nil {
+					err = memberEmit(Event{Type: EventContent, Text: res.Analysis})
+				}
+			} else {
+				r := req
+				r.Model, r.Models = m.Model, nil
+				res, err = p.cachedGenerate(ctx, r, snap, memberEmit)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Printf("[Predict] ensemble member %s failed: %v", m.Model, err)
+				m.Error = i18n.Localize(err, req.Language)
+			} else {
+				m.Result = res
+			}
+			if emit != nil {
+				_ = emit(Event{Type: EventModelDone, Data: m}) // 失败时已记入 emitErr
+			}
+		}(m)
+	}
+	wg.Wait()
+	if emitErr != nil {
+		return nil, emitErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	res := vote(req, snap, members)
+	if res == nil {
+		return nil, i18n.Errorf(i18n.MsgEnsembleAllFailed, members[0].Error)
+	}
+	p.calibrate(res)
+	p.record(snap, res)
+	log.Printf("[Predict] ensemble code=%s models=%s direction=%s agreement=%.2f",
+		req.Code, strings.Join(req.Models, ","), res.Verdict.Direction, res.Ensemble.Agreement)
+	return res, nil
+}
+
+// ensembleMembers 按历史命中率确定各模型权重
+func (p *Predictor) ensembleMembers(models []string) []*EnsembleMember {
+	members := make([]*EnsembleMember, len(models))
+	var sum float64
+	known := 0
+	for i, m := range models {
+		members[i] = &EnsembleMember{Model: m}
+		if rate, n, ok := p.calibration.Accuracy(m); ok {
+			members[i].Accuracy, members[i].Samples = rate, n
+			sum += rate
+			known++
+		}
+	}
+	def := 1.0
+	if known > 0 {
+		def = sum / float64(known)
+	}
+	for _, m := range members {
+		m.Weight = def
+		if m.Samples > 0 {
+			m.Weight = m.Accuracy
+		}
+		m.Weight = roundTo(m.Weight, 4)
+	}
+	return members
+}
+
+// modelEmit 将文本增量改为带模型名的 model_* 事件，工具调用记录标注模型；emit 为 nil 时返回 nil（非流式）。
+func modelEmit(model string, emit EventFunc, mu *sync.Mutex) EventFunc {
+	if emit == nil {
+		return nil
+	}
+	return func(ev Event) error {
+		switch ev.Type {
+		case EventReasoning:
+			ev = Event{Type: EventModelReasoning, Data: ModelChunk{Model: model, Text: ev.Text}}
+		case EventContent:
+			ev = Event{Type: EventModelContent, Data: ModelChunk{Model: model, Text: ev.Text}}
+		case EventTool:
+			if inv, ok := ev.Data.(ToolInvocation); ok {
+				inv.Model = model
+				ev.Data = inv
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return emit(ev)
+	}
+}
+
+// vote 加权投票：得票最多的方向胜出（并列时为震荡），置信度为胜出方各模型置信度按权重之和占全部投票权重的比例，
+// 预计区间取胜出方各模型区间的加权平均。全部模型失败时返回 nil。
+func vote(req Request, snap *Snapshot, members []*EnsembleMember) *Result {
+	res := &Result{
+		Code:      req.Code,
+		Model:     EnsembleModel,
+		Mode:      ModeSingle,
+		Technical: snap.Technical,
+		Bands:     snap.Bands,
+		Ensemble:  &Ensemble{Members: members, Votes: map[string]float64{}},
+		Language:  req.Language,
+	}
+	ok := false
+	var total float64
+	for _, m := range members {
+		r := m.Result
+		if r == nil {
+			continue
+		}
+		ok = true
+		if res.NewsSummary == "" && m.Model != quant.ModelName {
+			res.NewsSummary = r.NewsSummary
+		}
+		for _, c := range r.ToolCalls {
+			c.Model = m.Model
+			res.ToolCalls = append(res.ToolCalls, c)
+		}
+		for _, w := range r.Warnings {
+			w.Model = m.Model
+			res.Warnings = append(res.Warnings, w)
+		}
+		if r.Verdict != nil && r.Verdict.Direction != "" {
+			res.Ensemble.Votes[r.Verdict.Direction] += m.Weight
+			total += m.Weight
+		}
+	}
+	if !ok {
+		return nil
+	}
+	if res.NewsSummary == "" {
+		res.NewsSummary = i18n.T(req.Language, i18n.MsgNewsQuant)
+	}
+
+	winner, best, tie := DirectionNeutral, 0.0, false
+	for _, dir := range []string{DirectionBullish, DirectionBearish, DirectionNeutral} {
+		switch w := res.Ensemble.Votes[dir]; {
+		case w > best:
+			winner, best, tie = dir, w, false
+		case w == best && w > 0:
+			tie = true
+		}
+	}
+	if tie {
+		winner = DirectionNeutral
+	}
+	for dir, w := range res.Ensemble.Votes {
+		res.Ensemble.Votes[dir] = roundTo(w, 4)
+	}
+
+	v := &Verdict{Direction: winner}
+	var conf, weight, priced float64
+	for _, m := range members {
+		if m.Result == nil || m.Result.Verdict == nil || m.Result.Verdict.Direction != winner {
+			continue
+		}
+		mv := m.Result.Verdict
+		conf += m.Weight * mv.Confidence
+		weight += m.Weight
+		v.ChangeLowPct += m.Weight * mv.ChangeLowPct
+		v.ChangeHighPct += m.Weight * mv.ChangeHighPct
+		if mv.PriceLow > 0 && mv.PriceHigh >= mv.PriceLow {
+			v.PriceLow += m.Weight * mv.PriceLow
+			v.PriceHigh += m.Weight * mv.PriceHigh
+			priced += m.Weight
+		}
+	}
+	if total > 0 {
+		v.Confidence = roundTo(conf/total, 4)
+		res.Ensemble.Agreement = roundTo(weight/total, 4)
+	}
+	if weight > 0 {
+		v.ChangeLowPct, v.ChangeHighPct = roundTo(v.ChangeLowPct/weight, 2), roundTo(v.ChangeHighPct/weight, 2)
+	}
+	if priced > 0 {
+		v.PriceLow, v.PriceHigh = roundTo(v.PriceLow/priced, 3), roundTo(v.PriceHigh/priced, 3)
+	}
+	res.Verdict, res.Confidence = v, v.Confidence
+	res.Analysis = ensembleMarkdown(res)
+	return res
+}
+
+// ensembleMarkdown 综合结果的分析正文（按 res.Language 输出）：各模型结论一览与投票结论
+func ensembleMarkdown(res *Result) string {
+	e, v, lang := res.Ensemble, res.Verdict, res.Language
+	var b strings.Builder
+	b.WriteString(i18n.T(lang, i18n.MsgEnsembleMembers))
+	for _, m := range e.Members {
+		if m.Samples > 0 {
+			b.WriteString(i18n.T(lang, i18n.MsgEnsembleMemberAcc, m.Model, m.Weight, m.Accuracy*100, m.Samples))
+		} else {
+			b.WriteString(i18n.T(lang, i18n.MsgEnsembleMember, m.Model, m.Weight))
+		}
+		switch {
+		case m.Error != "":
+			b.WriteString(i18n.T(lang, i18n.MsgEnsembleFailed, clip(m.Error, 60)))
+		case m.Result.Verdict == nil:
+			b.WriteString(i18n.T(lang, i18n.MsgEnsembleNoVerdict))
+		default:
+			mv := m.Result.Verdict
+			b.WriteString(i18n.T(lang, i18n.MsgVerdictSummary, directionLabel(mv.Direction, lang), mv.Confidence,
+				changeRange(DigestEntry{ChangeLowPct: mv.ChangeLowPct, ChangeHighPct: mv.ChangeHighPct})))
+		}
+		if m.Result != nil {
+			if s := firstSentence(m.Result.Analysis, 80); s != "" {
+				fmt.Fprintf(&b, " — %s", s)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(i18n.T(lang, i18n.MsgEnsembleSummary))
+	b.WriteString(i18n.T(lang, i18n.MsgEnsembleVotes, e.Votes[DirectionBullish], e.Votes[DirectionBearish], e.Votes[DirectionNeutral],
+		directionLabel(v.Direction, lang), e.Agreement*100, v.Confidence))
+	if v.ChangeLowPct != 0 || v.ChangeHighPct != 0 {
+		change := changeRange(DigestEntry{ChangeLowPct: v.ChangeLowPct, ChangeHighPct: v.ChangeHighPct})
+		if v.PriceLow > 0 {
+			b.WriteString(i18n.T(lang, i18n.MsgEnsemblePrice, change, v.PriceLow, v.PriceHigh))
+		} else {
+			b.WriteString(i18n.T(lang, i18n.MsgEnsembleChange, change))
+		}
+	}
+	if e.Agreement > 0 && e.Agreement < 0.6 {
+		b.WriteString(i18n.T(lang, i18n.MsgEnsembleSplit))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func roundTo(v float64, digits int) float64 {
+	p := math.Pow(10, float64(digits))
+	return math.Round(v*p) / p
+}
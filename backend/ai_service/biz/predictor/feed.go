@@ -0,0 +1,40 @@
+package predictor
+
+import "sync"
+
+// Feed 事件缓冲：按顺序保存一次预测产生的事件，支持多个订阅者从头重放并等待后续事件。
+type Feed struct {
+	mu     sync.Mutex
+	events []Event
+	done   bool
+	wake   chan struct{}
+}
+
+// NewFeed 创建空的事件缓冲
+func NewFeed() *Feed { return &Feed{wake: make(chan struct{})} }
+
+// Add 追加事件并唤醒等待者；可直接作为 EventFunc 使用
+func (f *Feed) Add(ev Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, ev)
+	close(f.wake)
+	f.wake = make(chan struct{})
+	return nil
+}
+
+// Finish 标记不会再有新事件
+func (f *Feed) Finish() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.done = true
+	close(f.wake)
+	f.wake = make(chan struct{})
+}
+
+// Read 返回 from 之后的事件、是否已结束，以及有新事件时会被关闭的通道
+func (f *Feed) Read(from int) ([]Event, bool, <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.events[from:], f.done, f.wake
+}
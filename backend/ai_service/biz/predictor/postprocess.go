@@ -0,0 +1,102 @@
+package predictor
+
+import (
+	"encoding/json"
+	"log"
+	"regexp"
+	"strings"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+)
+
+// 结论方向
+const (
+	DirectionBullish = "bullish" // 看多
+	DirectionBearish = "bearish" // 看空
+	DirectionNeutral = "neutral" // 震荡
+)
+
+// Verdict 结构化结论，由模型在回答末尾的 JSON 代码块给出。
+type Verdict struct {
+	Direction     string  `json:"direction"`
+	Confidence    float64 `json:"confidence"`
+	ChangeLowPct  float64 `json:"change_low_pct"`
+	ChangeHighPct float64 `json:"change_high_pct"`
+	PriceLow      float64 `json:"price_low"`
+	PriceHigh     float64 `json:"price_high"`
+}
+
+var (
+	// verdictFenceRe 回答末尾的 ```json {...} ``` 代码块
+	verdictFenceRe = regexp.MustCompile("(?s)```(?:json)?\\s*(\\{[^`]*\"direction\"[^`]*\\})\\s*```")
+	// verdictBareRe 未加代码块时，含 direction 的最后一个 JSON 对象
+	verdictBareRe = regexp.MustCompile(`(?s)\{[^{}]*"direction"[^{}]*\}`)
+)
+
+// directionAliases 兼容模型输出中文或其他写法
+var directionAliases = map[string]string{
+	"bullish": DirectionBullish, "看多": DirectionBullish, "看涨": DirectionBullish, "up": DirectionBullish, "看好": DirectionBullish,
+	"bearish": DirectionBearish, "看空": DirectionBearish, "看跌": DirectionBearish, "down": DirectionBearish, "看淡": DirectionBearish,
+	"neutral": DirectionNeutral, "震荡": DirectionNeutral, "中性": DirectionNeutral, "sideways": DirectionNeutral, "橫行": DirectionNeutral, "震盪": DirectionNeutral,
+}
+
+// NormalizeDirection 将方向统一为 bullish/bearish/neutral，无法识别时返回空串。
+func NormalizeDirection(s string) string {
+	return directionAliases[strings.ToLower(strings.TrimSpace(s))]
+}
+
+// parseVerdict 从回答中提取结构化结论，返回 (结论, 去掉 JSON 块后的正文)。未找到时结论为 nil、正文原样返回。
+func parseVerdict(text string) (*Verdict, string) {
+	raw, loc := "", []int(nil)
+	if m := verdictFenceRe.FindAllStringSubmatchIndex(text, -1); len(m) > 0 {
+		last := m[len(m)-1]
+		raw, loc = text[last[2]:last[3]], last[:2]
+	} else if m := verdictBareRe.FindAllStringIndex(text, -1); len(m) > 0 {
+		last := m[len(m)-1]
+		raw, loc = text[last[0]:last[1]], last
+	}
+	if raw == "" {
+		return nil, text
+	}
+	var v Verdict
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, text
+	}
+	v.Direction = NormalizeDirection(v.Direction)
+	if v.Direction == "" {
+		return nil, text
+	}
+	if v.Confidence < 0 || v.Confidence > 1 {
+		v.Confidence = 0
+	}
+	rest := strings.TrimSpace(text[:loc[0]] + text[loc[1]:])
+	return &v, rest
+}
+
+// postProcess 由 LLM 响应生成结果：取最终回答（为空时退回推理内容）并解析结构化结论。
+func postProcess(snap *Snapshot, resp *llm.Response) (*Result, error) {
+	analysis := strings.TrimSpace(resp.Content)
+	// 智谱推理模型在 finish_reason=length 时可能只填了 reasoning_content，content 为空，则用推理内容作为分析
+	if analysis == "" {
+		analysis = strings.TrimSpace(resp.Reasoning)
+	}
+	if analysis == "" {
+		log.Printf("[Predict] LLM 返回 content 为空, finish_reason=%s", resp.FinishReason)
+		return nil, i18n.Errorf(i18n.MsgEmptyAnswer)
+	}
+	verdict, analysis := parseVerdict(analysis)
+	confidence := 0.0 // 未给出时由 calibrate 取该模型的历史命中率
+	if verdict != nil {
+		confidence = verdict.Confidence
+	}
+	return &Result{
+		Code:       snap.Code,
+		Model:      resp.Model,
+		Analysis:   analysis,
+		Confidence: confidence,
+		Verdict:    verdict,
+		Technical:  snap.Technical,
+		Bands:      snap.Bands,
+	}, nil
+}
@@ -3,46 +3,25 @@ package predictor
 import (
 	"bufio"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+	"hk_stock_assistant/backend/ai_service/biz/calibration"
+	"hk_stock_assistant/backend/ai_service/biz/chat"
+	"hk_stock_assistant/backend/ai_service/biz/history"
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	"hk_stock_assistant/backend/ai_service/biz/prompt"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/ai_service/biz/tools"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
 	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
 )
 
-// 智谱推理模型（如 GLM-5）响应较慢，默认 120s；可通过环境变量 LLM_TIMEOUT_SEC 覆盖（单位：秒）
-var (
-	httpClientOnce    sync.Once
-	defaultHTTPClient *http.Client
-)
-
-func getLLMTimeoutSec() int {
-	sec := 120
-	if s := os.Getenv("LLM_TIMEOUT_SEC"); s != "" {
-		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n > 0 {
-			sec = n
-		}
-	}
-	return sec
-}
-
-func getHTTPClient() *http.Client {
-	httpClientOnce.Do(func() {
-		sec := getLLMTimeoutSec()
-		defaultHTTPClient = &http.Client{Timeout: time.Duration(sec) * time.Second}
-	})
-	return defaultHTTPClient
-}
-
 func loadEnv() {
 	dir, _ := os.Getwd()
 	if dir == "" {
@@ -76,40 +55,117 @@ func init() {
 }
 
 // Predictor 使用股票服务与可选 LLM 产出港股分析（参考 A 股助手：预拉取数据 + 结构化 prompt）。
+//
+// Predict 与 StreamPredict 走同一条流水线：采集上下文 → 渲染 prompt → 调用 LLM → 后处理，
+// 区别仅在于 LLM 调用是否流式，因此 RPC 与 SSE 两个入口产出的分析等价。
 type Predictor struct {
 	stockClient stockservice.Client
-	apiKey      string
-	baseURL     string
-	model       string
+	llm         *llm.Client
+	prompts     *prompt.Registry
+	history     *history.Store
+	chats       *chat.Store
+	tools       *tools.Set
+	toolSteps   int      // 最多工具调用轮数，0 为关闭
+	noTools     sync.Map // 已判定不支持工具调用的模型 -> 判定时间
+	noToolsTTL  time.Duration
+	cache       *resultCache
+	usage       *usage.Tracker
+	calibration *calibration.Calibrator
+}
+
+// New 创建 Predictor。优先 ZHIPU_API_KEY（智谱），否则 LLM_API_KEY + LLM_BASE_URL + LLM_MODEL（OpenAI 兼容）；
+// prompt 模板见 biz/prompt（AI_PROMPT_DIR），预测记录写入数据目录（AI_DATA_DIR），
+// 工具调用轮数由 AI_TOOL_MAX_STEPS 控制（默认 4，0 关闭），模型拒绝 tools 后按 AI_NO_TOOLS_TTL_MIN 重新尝试，置信度校准见 biz/calibration。
+func New(stockClient stockservice.Client) *Predictor {
+	hist := history.NewStore()
+	return &Predictor{
+		stockClient: stockClient,
+		llm:         llm.NewFromEnv(),
+		prompts:     prompt.NewFromEnv(),
+		history:     hist,
+		chats:       chat.NewStore(),
+		tools:       tools.New(stockClient),
+		toolSteps:   toolStepsFromEnv(),
+		noToolsTTL:  noToolsTTLFromEnv(),
+		cache:       newResultCache(),
+		usage:       usage.NewFromEnv(),
+		calibration: calibration.NewFromEnv(stockClient, hist),
+	}
+}
+
+// Usage LLM 用量与预算
+func (p *Predictor) Usage() *usage.Tracker { return p.usage }
+
+// Calibration 置信度校准
+func (p *Predictor) Calibration() *calibration.Calibrator { return p.calibration }
+
+// predictionTemplateID 个股预测使用的模板 id
+const predictionTemplateID = "prediction"
+
+// Request 预测请求
+type Request struct {
+	Code            string   `json:"code"`
+	Days            int32    `json:"days"`                       // 预测周期（天），<=0 时取 3
+	Model           string   `json:"model,omitempty"`            // 为空时使用默认模型
+	TemplateVersion string   `json:"template_version,omitempty"` // 指定模板版本（如 v2 或 prediction@v2），为空时按权重 A/B 选择；仅单次分析模式使用
+	Mode            string   `json:"mode,omitempty"`             // ModeSingle（默认）或 ModeDebate
+	ForceRefresh    bool     `json:"force_refresh,omitempty"`    // 跳过结果缓存重新调用 LLM
+	Models          []string `json:"models,omitempty"`           // 对比的多个模型（2～5 个，可含 quant），同一数据快照并发预测并投票，此时忽略 Model
+	Language        string   `json:"language,omitempty"`         // 输出语言 zh-CN（默认）、zh-TW 或 en，见 i18n.Normalize
+}
+
+// Result 单次预测结果；SSE 的 result 事件与 RPC 响应均由它生成。
+type Result struct {
+	ID              string           `json:"prediction_id,omitempty"` // 预测记录 ID（占位结果无 ID）
+	Code            string           `json:"code"`
+	Model           string           `json:"model,omitempty"`
+	Mode            string           `json:"mode,omitempty"`
+	TemplateVersion string           `json:"template_version,omitempty"` // 辩论模式为裁判模板
+	Analysis        string           `json:"analysis"`
+	Confidence      float64          `json:"confidence"`               // 校准后的置信度，无校准数据时为模型原值
+	RawConfidence   float64          `json:"raw_confidence,omitempty"` // 模型给出的原始置信度，未给出时为 0
+	Calibrated      bool             `json:"calibrated,omitempty"`     // Confidence 是否经过校准
+	NewsSummary     string           `json:"news_summary"`
+	Verdict         *Verdict         `json:"verdict,omitempty"`    // 从回答末尾 JSON 解析的结构化结论，解析失败时为 nil
+	Technical       *Technical       `json:"technical,omitempty"`  // 日线技术指标，K 线获取失败时为 nil
+	ToolCalls       []ToolInvocation `json:"tool_calls,omitempty"` // 分析过程中模型调用的工具
+	Debate          *Debate          `json:"debate,omitempty"`     // 辩论模式下多空双方的论证
+	Cached          bool             `json:"cached,omitempty"`     // 来自结果缓存或与进行中的相同请求合并
+	Bands           *quant.Bands     `json:"bands,omitempty"`      // 蒙特卡洛价格分位带（预测周期内逐日），日 K 不足时为 nil
+	Ensemble        *Ensemble        `json:"ensemble,omitempty"`   // 多模型对比时各模型的结果与投票
+	Warnings        []Warning        `json:"warnings,omitempty"`   // 数字核对：回答引用的数字与数据快照不符、结论区间不合理等
+	Language        string           `json:"language,omitempty"`   // 输出语言
+	Context         *DataSnapshot    `json:"context,omitempty"`    // 模型所见的输入数据与各项获取情况
 }
 
+// 流式预测事件类型
 const (
-	zhipuBaseURL = "https://open.bigmodel.cn/api/paas/v4"
-	zhipuModel   = "glm-4-flash"
+	EventContext   = "context"          // Data 为 *DataSnapshot，采集完数据后最先发送（关键数据缺失而拒绝预测时也会发送）
+	EventTechnical = "technical"        // Data 为 *Technical，调用 LLM 前发送
+	EventBands     = "bands"            // Data 为 *quant.Bands，调用 LLM 前发送
+	EventReasoning = llm.DeltaReasoning // Text 为思考过程增量
+	EventContent   = llm.DeltaContent   // Text 为最终输出增量
+	EventTool      = "tool"             // Data 为 ToolInvocation，每次工具调用完成后发送
+	EventResult    = "result"           // Data 为 *Result，后处理完成后发送
+	EventQueue     = "queue"            // Data 为 llm.WaitStatus，LLM 调用排队、限速或重试等待时发送
+	EventWarnings  = "warnings"         // Data 为 []Warning，有数字核对告警时紧随 result 发送
+
+	// 多模型对比时各模型的文本增量与结果，工具调用仍以 tool 事件发送并标注 model
+	EventModelReasoning = "model_reasoning" // Data 为 ModelChunk
+	EventModelContent   = "model_content"   // Data 为 ModelChunk
+	EventModelDone      = "model_done"      // Data 为 EnsembleMember，某个模型完成或失败时发送
 )
 
-// New 创建 Predictor。优先 ZHIPU_API_KEY（智谱），否则 LLM_API_KEY + LLM_BASE_URL + LLM_MODEL（OpenAI 兼容）。
-func New(stockClient stockservice.Client) *Predictor {
-	apiKey := os.Getenv("ZHIPU_API_KEY")
-	baseURL := zhipuBaseURL
-	model := os.Getenv("ZHIPU_MODEL")
-	if model == "" {
-		model = zhipuModel
-	}
-	if apiKey == "" {
-		apiKey = os.Getenv("LLM_API_KEY")
-		baseURL = os.Getenv("LLM_BASE_URL")
-		if baseURL == "" {
-			baseURL = "https://api.openai.com/v1"
-		}
-		model = os.Getenv("LLM_MODEL")
-		if model == "" {
-			model = "gpt-4o-mini"
-		}
-	}
-	return &Predictor{stockClient: stockClient, apiKey: apiKey, baseURL: baseURL, model: model}
+// Event 流式预测事件：文本增量放在 Text，结构化数据放在 Data。
+type Event struct {
+	Type string
+	Text string
+	Data interface{}
 }
 
+// EventFunc 接收流式事件，返回 error 时中止预测。
+type EventFunc func(Event) error
+
 // IsHKTradingTime 判断当前是否港股交易时段（香港时间 9:30-12:00, 13:00-16:00，周一至周五）。
 func IsHKTradingTime() bool {
 	loc, err := time.LoadLocation("Asia/Hong_Kong")
@@ -133,328 +189,222 @@ func IsHKTradingTime() bool {
 	return false
 }
 
-// fetchStockData 预拉取个股实时行情。
-func (p *Predictor) fetchStockData(ctx context.Context, code string) string {
-	rpcResp, err := p.stockClient.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: code})
-	if err != nil {
-		return fmt.Sprintf("获取行情失败: %v", err)
-	}
-	if rpcResp == nil || rpcResp.Stock == nil {
-		return "无行情数据"
+// Predict 非流式预测。
+func (p *Predictor) Predict(ctx context.Context, req Request) (*Result, error) {
+	return p.run(ctx, req, nil)
+}
+
+// StreamPredict 流式预测：依次发送 technical、reasoning/content 增量（含 tool 调用事件）与最终 result 事件。
+func (p *Predictor) StreamPredict(ctx context.Context, req Request, onEvent EventFunc) (*Result, error) {
+	if onEvent == nil {
+		onEvent = func(Event) error { return nil }
 	}
-	s := rpcResp.Stock
-	return fmt.Sprintf("名称=%s, 代码=%s, 现价=%.2f, 涨跌幅=%.2f%%, 成交量=%d",
-		s.Name, s.Code, s.CurrentPrice, s.ChangePercent, s.Volume)
+	return p.run(ctx, req, onEvent)
 }
 
-// fetchMarketData 预拉取大盘指数（恒生等）。
-func (p *Predictor) fetchMarketData(ctx context.Context) string {
-	rpcResp, err := p.stockClient.GetMarketSummary(ctx, &stock.GetMarketSummaryRequest{})
+// run 预测流水线；emit 为 nil 时以非流式调用 LLM。
+func (p *Predictor) run(ctx context.Context, req Request, emit EventFunc) (*Result, error) {
+	req, err := p.prepare(req)
 	if err != nil {
-		return fmt.Sprintf("获取大盘失败: %v", err)
+		return nil, err
 	}
-	if rpcResp == nil || len(rpcResp.Indices) == 0 {
-		return "无大盘数据"
+	log.Printf("[Predict] start code=%s days=%d mode=%s stream=%v", req.Code, req.Days, req.Mode, emit != nil)
+
+	// 1. 采集上下文（参考 A 股：先拿齐再拼 prompt）
+	snap := p.gatherContext(ctx, req.Code, req.Days)
+	log.Printf("[Predict] data fetched, stock=%s", truncate(snap.Stock, 80))
+	if emit != nil {
+		if err := emit(Event{Type: EventContext, Data: snap.Data()}); err != nil {
+			return nil, err
+		}
 	}
-	var lines []string
-	for _, idx := range rpcResp.Indices {
-		if idx == nil {
-			continue
+	if err := snap.checkCritical(); err != nil {
+		return nil, err
+	}
+	if emit != nil && snap.Technical != nil {
+		if err := emit(Event{Type: EventTechnical, Data: snap.Technical}); err != nil {
+			return nil, err
+		}
+	}
+	if emit != nil && snap.Bands != nil {
+		if err := emit(Event{Type: EventBands, Data: snap.Bands}); err != nil {
+			return nil, err
 		}
-		lines = append(lines, fmt.Sprintf("%s: %.2f, 涨跌%.2f%%, 变动%.2f",
-			idx.Name, idx.Value, idx.ChangePercent, idx.Change))
 	}
-	return strings.Join(lines, "\n")
-}
 
-// Predict 返回 analysis, confidence, newsSummary。
-func (p *Predictor) Predict(ctx context.Context, code string, days int32, modelOverride string) (string, float64, string, error) {
-	log.Printf("[Predict] start code=%s days=%d", code, days)
-	// 1. 预拉取数据（参考 A 股：先拿齐再拼 prompt）
-	stockStr := p.fetchStockData(ctx, code)
-	marketStr := p.fetchMarketData(ctx)
-	log.Printf("[Predict] data fetched, stock=%s", truncate(stockStr, 80))
-
-	// 2. 无 API Key 时返回占位
-	if p.apiKey == "" {
-		return fmt.Sprintf("【港股 %s】\n当前数据：%s\n\n大盘：\n%s\n\n请设置环境变量 ZHIPU_API_KEY 或 LLM_API_KEY 后使用 AI 预测。", code, stockStr, marketStr),
-			0.5, "参见分析内容。", nil
-	}
-
-	// 3. 港股交易时段与预测焦点
-	isTrading := IsHKTradingTime()
-	tradingStatusStr := "港股休市"
-	predictionFocus := "未来 1 个交易日及未来 " + fmt.Sprintf("%d", days) + " 天走势"
-	timeInstruction := `
-- 当前状态：港股休市（盘后/周末）
-- 重点：结合全日表现与大盘环境，给出下一交易日及未来数日的展望。
-`
-
-	if isTrading {
-		tradingStatusStr = "港股盘中（9:30-12:00, 13:00-16:00 香港时间）"
-		predictionFocus = "今日收盘走势及未来 " + fmt.Sprintf("%d", days) + " 天"
-		timeInstruction = `
-- 当前状态：港股盘中交易中
-- 重点：结合实时价格、涨跌幅、成交量与大盘联动，判断尾盘及短期方向。
-`
-	}
-
-	model := modelOverride
-	if model == "" {
-		model = p.model
-	}
-
-	// 4. 结构化 prompt（参考 A 股：角色 + 时间 + 数据块 + 分析框架 + 输出要求）
-	prompt := fmt.Sprintf(`你是一位港股分析专家（专业基金经理水平）。请根据以下数据对港股 %s 做简明分析与预测。
-
-当前时间与状态：%s（%s）
-
-[个股实时数据]
-%s
-
-[大盘指数]
-%s
-
-请按以下逻辑组织回答（不必逐条标题，但需覆盖要点）：
-1. 时间与大盘环境：结合当前是否盘中、大盘涨跌，说明对个股的影响。
-2. 个股逻辑：价格、涨跌幅、成交量反映的资金与情绪。
-3. 风险提示：若波动剧烈或大盘偏弱，需提示风险。
-4. 预测：对「%s」给出方向判断（看多/看空/震荡）及简要理由。
-5. 预计涨幅与预计价格：对上述预测周期给出预计涨跌幅区间或中枢（例如 +2%%～+5%%）以及对应的预计价格或价格区间（结合当前价给出，如当前 100 港元则预计 102～105 港元），并简要说明依据。
-6. 置信度：0～1 之间的数值。
-
-输出要求：
-- 语言：简体中文。
-- 风格：专业、客观、简洁（2～4 段即可）。
-- 不要编造未提供的数据。
-`, code, time.Now().Format("2006-01-02 15:04:05"), tradingStatusStr, stockStr, marketStr, predictionFocus)
-	prompt += "\n" + strings.TrimSpace(timeInstruction) + "\n\n请直接输出你的分析结论。"
-
-	// 5. 调用 OpenAI 兼容 API
-	body := map[string]interface{}{
-		"model": model,
-		"messages": []map[string]string{
-			{"role": "user", "content": prompt},
-		},
-		"max_tokens": 4000, // 推理模型（如 GLM-5）需更多 token，避免 finish_reason=length 时仅 reasoning_content 有内容
-	}
-	bodyBytes, err := json.Marshal(body)
-	if err != nil {
-		return "", 0, "", fmt.Errorf("构建请求体: %w", err)
+	// 2. 多模型对比：同一快照并发预测后投票
+	if len(req.Models) > 0 {
+		res, err := p.ensemble(ctx, req, snap, emit)
+		if err != nil {
+			return nil, err
+		}
+		attachContext(res, snap, req.Language)
+		return res, emitResult(emit, res, false)
 	}
-	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/chat/completions", strings.NewReader(string(bodyBytes)))
-	if err != nil {
-		return "", 0, "", err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+p.apiKey)
-	// 使用独立 context，避免调用方（网关）RPC 超时后取消导致 LLM 请求被取消
-	timeoutSec := getLLMTimeoutSec()
-	llmCtx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
-	defer cancel()
-	req = req.WithContext(llmCtx)
-	log.Printf("[Predict] calling LLM model=%s (timeout=%ds)", model, timeoutSec)
-	resp, err := getHTTPClient().Do(req)
-	if err != nil {
-		log.Printf("[Predict] LLM request error: %v", err)
-		return "", 0, "", fmt.Errorf("调用 LLM 失败: %w", err)
+
+	// 3. 未配置 API Key 或指定 model=quant 时使用规则量化模型
+	if req.Model == quant.ModelName {
+		res, err := p.quantPredict(ctx, req, snap)
+		if err != nil {
+			return nil, err
+		}
+		attachContext(res, snap, req.Language)
+		return res, emitResult(emit, res, true)
 	}
-	defer resp.Body.Close()
-	log.Printf("[Predict] LLM response status=%d", resp.StatusCode)
-	respBytes, err := io.ReadAll(resp.Body)
+
+	// 4. 相同请求与数据快照命中缓存时直接重放，否则生成
+	res, err := p.cachedGenerate(ctx, req, snap, emit)
 	if err != nil {
-		return "", 0, "", fmt.Errorf("读取 LLM 响应: %w", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return "", 0, "", fmt.Errorf("LLM 返回 %d: %s", resp.StatusCode, string(respBytes))
-	}
-	var out struct {
-		Error *struct {
-			Message string `json:"message"`
-			Code   string `json:"code"`
-		} `json:"error"`
-		Choices []struct {
-			Message struct {
-				Content          interface{} `json:"content"`           // 最终回答
-				ReasoningContent string      `json:"reasoning_content"` // 智谱推理模型（如 GLM-5）的思考过程，finish_reason=length 时可能只有此项
-			} `json:"message"`
-			FinishReason string `json:"finish_reason"`
-		} `json:"choices"`
-	}
-	if err := json.Unmarshal(respBytes, &out); err != nil {
-		return "", 0, "", fmt.Errorf("解析 LLM 响应: %w", err)
-	}
-	if out.Error != nil && out.Error.Message != "" {
-		return "", 0, "", fmt.Errorf("LLM 错误: %s", out.Error.Message)
-	}
-	if len(out.Choices) == 0 {
-		log.Printf("[Predict] LLM 响应无 choices，原始响应(前500字): %s", truncate(string(respBytes), 500))
-		return "", 0, "", fmt.Errorf("LLM 未返回内容")
-	}
-	content := out.Choices[0].Message.Content
-	analysis := contentToString(content)
-	analysis = strings.TrimSpace(analysis)
-	// 智谱推理模型在 finish_reason=length 时可能只填了 reasoning_content，content 为空，则用推理内容作为分析
-	if analysis == "" {
-		analysis = strings.TrimSpace(out.Choices[0].Message.ReasoningContent)
-	}
-	if analysis == "" {
-		log.Printf("[Predict] LLM 返回 content 为空, finish_reason=%s, 原始响应(前500字): %s",
-			out.Choices[0].FinishReason, truncate(string(respBytes), 500))
-		return "", 0, "", fmt.Errorf("LLM 返回内容为空（可能触发内容策略或模型限制，请稍后重试或换用其他模型）")
-	}
-	return analysis, 0.85, "参见分析内容。", nil
+		return nil, err
+	}
+	attachContext(res, snap, req.Language)
+	return res, emitResult(emit, res, false)
 }
 
-// buildPromptForLLM 返回 (prompt, model, error)。无 API Key 时返回 error。
-func (p *Predictor) buildPromptForLLM(ctx context.Context, code string, days int32, modelOverride string) (prompt, model string, err error) {
-	stockStr := p.fetchStockData(ctx, code)
-	marketStr := p.fetchMarketData(ctx)
-	if p.apiKey == "" {
-		return "", "", fmt.Errorf("未配置 ZHIPU_API_KEY 或 LLM_API_KEY")
-	}
-	isTrading := IsHKTradingTime()
-	tradingStatusStr := "港股休市"
-	predictionFocus := "未来 1 个交易日及未来 " + fmt.Sprintf("%d", days) + " 天走势"
-	timeInstruction := "- 当前状态：港股休市（盘后/周末）\n- 重点：结合全日表现与大盘环境，给出下一交易日及未来数日的展望。"
-	if isTrading {
-		tradingStatusStr = "港股盘中（9:30-12:00, 13:00-16:00 香港时间）"
-		predictionFocus = "今日收盘走势及未来 " + fmt.Sprintf("%d", days) + " 天"
-		timeInstruction = "- 当前状态：港股盘中交易中\n- 重点：结合实时价格、涨跌幅、成交量与大盘联动，判断尾盘及短期方向。"
-	}
-	model = modelOverride
-	if model == "" {
-		model = p.model
-	}
-	prompt = fmt.Sprintf(`你是一位港股分析专家（专业基金经理水平）。请根据以下数据对港股 %s 做简明分析与预测。
-
-当前时间与状态：%s（%s）
-
-[个股实时数据]
-%s
-
-[大盘指数]
-%s
-
-请按以下逻辑组织回答（不必逐条标题，但需覆盖要点）：
-1. 时间与大盘环境：结合当前是否盘中、大盘涨跌，说明对个股的影响。
-2. 个股逻辑：价格、涨跌幅、成交量反映的资金与情绪。
-3. 风险提示：若波动剧烈或大盘偏弱，需提示风险。
-4. 预测：对「%s」给出方向判断（看多/看空/震荡）及简要理由。
-5. 预计涨幅与预计价格：对上述预测周期给出预计涨跌幅区间或中枢（例如 +2%%～+5%%）以及对应的预计价格或价格区间（结合当前价给出，如当前 100 港元则预计 102～105 港元），并简要说明依据。
-6. 置信度：0～1 之间的数值。
-
-输出要求：
-- 语言：简体中文。
-- 风格：专业、客观、简洁（2～4 段即可）。
-- 不要编造未提供的数据。
-%s
-
-请直接输出你的分析结论。`, code, time.Now().Format("2006-01-02 15:04:05"), tradingStatusStr, stockStr, marketStr, predictionFocus, timeInstruction)
-	return prompt, model, nil
+// attachContext 在结果中附上输入数据快照，非关键数据缺失的告警排在数字核对告警之前（res 可能是缓存结果的副本，不修改原切片）
+func attachContext(res *Result, snap *Snapshot, lang string) {
+	res.Context = snap.Data()
+	if ws := snap.dataWarnings(lang); len(ws) > 0 {
+		res.Warnings = append(ws, res.Warnings...)
+	}
 }
 
-// StreamPredict 流式调用 LLM，每收到一段内容就调用 onChunk(eventType, delta)。
-// eventType 为 "reasoning"（思考过程）或 "content"（最终输出）；智谱/OpenAI 兼容 stream 格式。
-func (p *Predictor) StreamPredict(ctx context.Context, code string, days int32, modelOverride string, onChunk func(eventType string, text string) error) error {
-	prompt, model, err := p.buildPromptForLLM(ctx, code, days, modelOverride)
-	if err != nil {
-		return err
+// CheckDays 校验预测周期：<=0 表示默认 3 天，超过 quant.MaxDays 时返回错误
+func CheckDays(days int32) error {
+	if days > quant.MaxDays {
+		return i18n.Errorf(i18n.MsgDaysOutOfRange, quant.MaxDays, days)
+	}
+	return nil
+}
+
+// prepare 补全请求默认值（周期、模型、模式），并按每日预算确认或降级 LLM 模型。
+func (p *Predictor) prepare(req Request) (Request, error) {
+	if err := CheckDays(req.Days); err != nil {
+		return req, err
 	}
-	body := map[string]interface{}{
-		"model": model,
-		"messages": []map[string]string{
-			{"role": "user", "content": prompt},
-		},
-		"max_tokens": 4000,
-		"stream":     true,
+	if req.Days <= 0 {
+		req.Days = 3
 	}
-	bodyBytes, err := json.Marshal(body)
+	lang, err := i18n.Normalize(req.Language)
 	if err != nil {
-		return fmt.Errorf("构建请求体: %w", err)
+		return req, err
+	}
+	req.Language = lang
+	if len(req.Models) > 0 {
+		return p.prepareEnsemble(req)
+	}
+	switch {
+	case !p.llm.Configured():
+		req.Model = quant.ModelName
+	case req.Model == "":
+		req.Model = p.llm.DefaultModel()
+	}
+	if req.Model != quant.ModelName {
+		model, err := p.usage.Admit(req.Model)
+		if err != nil {
+			return req, err
+		}
+		req.Model = model
+	}
+	if req.Mode == "" {
+		req.Mode = ModeSingle
+	}
+	if req.Mode != ModeSingle && req.Mode != ModeDebate {
+		return req, i18n.Errorf(i18n.MsgUnsupportedMode, req.Mode, ModeSingle, ModeDebate)
+	}
+	return req, nil
+}
+
+// generate 渲染 prompt 并调用 LLM（流式/非流式仅为传输方式不同），模型可按需调用工具补充数据；后处理并记录。
+// 历史快照不启用工具（工具返回的是最新数据）、不写预测记录。
+func (p *Predictor) generate(ctx context.Context, req Request, snap *Snapshot, emit EventFunc) (*Result, error) {
+	var (
+		resp     *llm.Response
+		calls    []ToolInvocation
+		template string
+		debate   *Debate
+		err      error
+	)
+	data := newPromptData(snap, req.Language)
+	useTools := !snap.Historical && p.toolsEnabled(req.Model)
+	if req.Mode == ModeDebate {
+		resp, calls, template, debate, err = p.debate(ctx, req, data, useTools, emit)
+	} else {
+		resp, calls, template, err = p.ask(ctx, req.Model, predictionTemplateID, req.TemplateVersion, data, useTools, emit)
 	}
-	timeoutSec := getLLMTimeoutSec()
-	llmCtx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
-	defer cancel()
-	req, err := http.NewRequestWithContext(llmCtx, "POST", p.baseURL+"/chat/completions", strings.NewReader(string(bodyBytes)))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+p.apiKey)
-	log.Printf("[StreamPredict] calling LLM model=%s stream=true", model)
-	resp, err := getHTTPClient().Do(req)
+
+	res, err := postProcess(snap, resp)
 	if err != nil {
-		return fmt.Errorf("调用 LLM 失败: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		bs, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("LLM 返回 %d: %s", resp.StatusCode, string(bs))
+	res.Mode, res.TemplateVersion, res.ToolCalls, res.Debate = req.Mode, template, calls, debate
+	res.Language, res.NewsSummary = req.Language, i18n.T(req.Language, i18n.MsgNewsInAnalysis)
+	res.Warnings = verifyNumbers(snap, res)
+	p.calibrate(res)
+	if !snap.Historical {
+		p.record(snap, res)
 	}
-	sc := bufio.NewScanner(resp.Body)
-	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
-	for sc.Scan() {
-		line := strings.TrimSpace(sc.Text())
-		if line == "" {
-			continue
-		}
-		if !strings.HasPrefix(line, "data: ") {
-			continue
-		}
-		payload := strings.TrimPrefix(line, "data: ")
-		if payload == "[DONE]" {
-			break
-		}
-		var chunk struct {
-			Choices []struct {
-				Delta struct {
-					Content          string `json:"content"`
-					ReasoningContent string `json:"reasoning_content"` // 智谱 GLM-5 流式推理内容
-				} `json:"delta"`
-			} `json:"choices"`
-		}
-		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
-			continue
-		}
-		if len(chunk.Choices) == 0 {
-			continue
-		}
-		delta := chunk.Choices[0].Delta
-		if delta.ReasoningContent != "" && onChunk != nil {
-			if err := onChunk("reasoning", delta.ReasoningContent); err != nil {
-				return err
-			}
-		}
-		if delta.Content != "" && onChunk != nil {
-			if err := onChunk("content", delta.Content); err != nil {
-				return err
-			}
-		}
+	return res, nil
+}
+
+// calibrate 按模型与模板版本的历史命中情况校准置信度（结论中的置信度同步替换），原值保存在 RawConfidence。
+func (p *Predictor) calibrate(res *Result) {
+	res.RawConfidence = res.Confidence
+	res.Confidence, res.Calibrated = p.calibration.Apply(res.Model, res.TemplateVersion, res.RawConfidence)
+	if res.Verdict != nil {
+		res.Verdict.Confidence = res.Confidence
 	}
-	return sc.Err()
 }
 
-func contentToString(c interface{}) string {
-	if c == nil {
-		return ""
-	}
-	switch v := c.(type) {
-	case string:
-		return v
-	case []interface{}:
-		var b strings.Builder
-		for _, part := range v {
-			if m, ok := part.(map[string]interface{}); ok {
-				if t, ok := m["text"].(string); ok {
-					b.WriteString(t)
-				}
-			}
+// record 写入预测记录与详情并回填 ID；写入失败只记日志，不影响返回结果。
+func (p *Predictor) record(snap *Snapshot, res *Result) {
+	raw := res.RawConfidence
+	rec := &history.Record{
+		Code:            res.Code,
+		Days:            snap.Days,
+		Model:           res.Model,
+		TemplateVersion: res.TemplateVersion,
+		Mode:            res.Mode,
+		Confidence:      res.Confidence,
+		RawConfidence:   &raw,
+	}
+	if snap.Quote != nil {
+		rec.Price = snap.Quote.CurrentPrice
+	}
+	if v := res.Verdict; v != nil {
+		rec.Direction, rec.PriceLow, rec.PriceHigh = v.Direction, v.PriceLow, v.PriceHigh
+	}
+	if err := p.history.Append(rec); err != nil {
+		log.Printf("[Predict] record history: %v", err)
+		return
+	}
+	res.ID = rec.ID
+	detail := &history.Detail{ID: rec.ID, Time: rec.Time, Code: res.Code, Model: res.Model, Context: snap.ContextText(), Analysis: res.Analysis}
+	if err := p.history.SaveDetail(detail); err != nil {
+		log.Printf("[Predict] save prediction detail: %v", err)
+	}
+}
+
+// emitResult 发送最终 result 事件（有数字核对告警时随后发送 warnings 事件）；withContent 为 true 时先把分析整体作为一段 content 发送（非 LLM 产出的结果）。
+func emitResult(emit EventFunc, res *Result, withContent bool) error {
+	if emit == nil {
+		return nil
+	}
+	if withContent {
+		if err := emit(Event{Type: EventContent, Text: res.Analysis}); err != nil {
+			return err
 		}
-		return b.String()
-	default:
-		return fmt.Sprint(c)
 	}
+	if err := emit(Event{Type: EventResult, Data: res}); err != nil {
+		return err
+	}
+	if len(res.Warnings) > 0 {
+		return emit(Event{Type: EventWarnings, Data: res.Warnings})
+	}
+	return nil
 }
 
 func truncate(s string, max int) string {
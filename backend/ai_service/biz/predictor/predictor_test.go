@@ -0,0 +1,444 @@
+package predictor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	"hk_stock_assistant/backend/ai_service/biz/llm/llmmock"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+// fakeStock 固定行情的股票服务，未覆盖的方法调用时 panic
+type fakeStock struct {
+	stockservice.Client
+	quoteErr   error
+	noOverview bool // GetMarketOverview 返回 nil 响应
+}
+
+func (f *fakeStock) GetRealtime(_ context.Context, req *stock.GetRealtimeRequest, _ ...callopt.Option) (*stock.GetRealtimeResponse, error) {
+	if f.quoteErr != nil {
+		return nil, f.quoteErr
+	}
+	return &stock.GetRealtimeResponse{Stock: &stock.StockInfo{Code: req.Code, Name: "腾讯控股", CurrentPrice: 400, ChangePercent: 1.5, Volume: 1000000}}, nil
+}
+
+func (f *fakeStock) GetMarketSummary(context.Context, *stock.GetMarketSummaryRequest, ...callopt.Option) (*stock.GetMarketSummaryResponse, error) {
+	return &stock.GetMarketSummaryResponse{Indices: []*stock.MarketIndex{{Name: "恒生指数", Value: 20000, Change: 100, ChangePercent: 0.5}}}, nil
+}
+
+func (f *fakeStock) GetMarketOverview(context.Context, *stock.GetMarketOverviewRequest, ...callopt.Option) (*stock.GetMarketOverviewResponse, error) {
+	if f.noOverview {
+		return nil, nil
+	}
+	return &stock.GetMarketOverviewResponse{
+		Indices: []*stock.MarketIndex{{Name: "恒生指数", Value: 20000, Change: 100, ChangePercent: 0.5}},
+		Breadth: &stock.MarketBreadth{Advancers: 600, Decliners: 400, Unchanged: 100},
+	}, nil
+}
+
+func (f *fakeStock) GetKline(_ context.Context, req *stock.GetKlineRequest, _ ...callopt.Option) (*stock.GetKlineResponse, error) {
+	n := int(req.Limit)
+	start := time.Now().AddDate(0, 0, -n)
+	bars := make([]*stock.KLine, n)
+	for i := range bars {
+		c := 380 + float64(i%10)*2
+		bars[i] = &stock.KLine{Date: start.AddDate(0, 0, i).Format("2006-01-02"), Open: c - 1, Close: c, High: c + 3, Low: c - 3, Volume: 100000}
+	}
+	return &stock.GetKlineResponse{Klines: bars}, nil
+}
+
+const mockAnswer = "腾讯控股现价 400.00，短期震荡。\n```json\n{\"direction\":\"up\",\"confidence\":0.7,\"change_low_pct\":-1,\"change_high_pct\":3}\n```"
+
+// newTestPredictor 指向模拟 LLM 的 Predictor，数据写入临时目录
+func newTestPredictor(t *testing.T, sc *fakeStock, replies ...llmmock.Reply) (*llmmock.Server, *Predictor) {
+	t.Helper()
+	mock := llmmock.New(replies...)
+	ts := httptest.NewServer(mock)
+	t.Cleanup(ts.Close)
+	t.Setenv("AI_DATA_DIR", t.TempDir())
+	t.Setenv("ZHIPU_API_KEY", "")
+	t.Setenv("LLM_PROVIDERS", "")
+	t.Setenv("LLM_API_KEY", "mock")
+	t.Setenv("LLM_BASE_URL", ts.URL+"/v1")
+	t.Setenv("LLM_MODEL", "mock")
+	t.Setenv("LLM_RETRY_BASE_MS", "1")
+	t.Setenv("AI_TOOL_MAX_STEPS", "0")
+	t.Setenv("AI_DAILY_BUDGET", "")
+	p := New(sc)
+	// 生成在脱离请求 ctx 的单飞中运行，须在恢复环境变量前结束，否则用量会写到默认数据目录
+	t.Cleanup(func() { waitFlights(t, p) })
+	return mock, p
+}
+
+// waitFlights 等待所有后台生成结束（含已取消的及其用量记录）
+func waitFlights(t *testing.T, p *Predictor) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		p.cache.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight generation did not finish")
+	}
+}
+
+func TestPredict(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer, Reasoning: "看看均线"})
+	res, err := p.Predict(context.Background(), Request{Code: "hk00700"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ID == "" || res.Model != "mock" {
+		t.Errorf("res = %+v", res)
+	}
+	if res.Verdict == nil || res.Verdict.Direction != "bullish" || res.RawConfidence != 0.7 {
+		t.Errorf("verdict = %+v, raw confidence %v", res.Verdict, res.RawConfidence)
+	}
+	if res.Context == nil || res.Context.Quote == nil || len(res.Context.Sources) == 0 {
+		t.Errorf("context = %+v", res.Context)
+	}
+	// 生成经由缓存的单飞流程，非流式请求同样以流式调用 LLM
+	reqs := mock.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %+v", reqs)
+	}
+	if prompt := reqs[0].Messages[len(reqs[0].Messages)-1].Content; !strings.Contains(prompt, "腾讯控股") || !strings.Contains(prompt, "恒生指数") {
+		t.Errorf("prompt missing snapshot data: %s", prompt)
+	}
+
+	// 相同请求与快照命中缓存，不再调用 LLM
+	again, err := p.Predict(context.Background(), Request{Code: "hk00700"})
+	if err != nil || !again.Cached || len(mock.Requests()) != 1 {
+		t.Errorf("second call cached=%v err=%v requests=%d", again != nil && again.Cached, err, len(mock.Requests()))
+	}
+}
+
+func TestPredictCacheTemplate(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer})
+	res, err := p.Predict(context.Background(), Request{Code: "hk00700"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.TemplateVersion, predictionTemplateID+"@") {
+		t.Fatalf("template = %q", res.TemplateVersion)
+	}
+	// 显式指定按权重选中的版本与之共用缓存
+	again, err := p.Predict(context.Background(), Request{Code: "hk00700", TemplateVersion: res.TemplateVersion})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Cached || len(mock.Requests()) != 1 {
+		t.Errorf("cached = %v, llm calls = %d, want a cache hit keyed on %s", again.Cached, len(mock.Requests()), res.TemplateVersion)
+	}
+	if _, err := p.Predict(context.Background(), Request{Code: "hk00700", TemplateVersion: "commentary@v1"}); err == nil {
+		t.Error("template of another id accepted")
+	}
+}
+
+func TestStreamPredict(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer, Reasoning: "看看均线"})
+	var types []string
+	var content strings.Builder
+	res, err := p.StreamPredict(context.Background(), Request{Code: "hk00700", Language: "en"}, func(ev Event) error {
+		if len(types) == 0 || types[len(types)-1] != ev.Type {
+			types = append(types, ev.Type)
+		}
+		if ev.Type == EventContent {
+			content.WriteString(ev.Text)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{EventContext, EventTechnical, EventBands, EventReasoning, EventContent, EventResult}
+	if strings.Join(types, ",") != strings.Join(want, ",") {
+		t.Errorf("events = %v, want %v", types, want)
+	}
+	if content.String() != mockAnswer || res.Language != i18n.En || res.Verdict == nil {
+		t.Errorf("content = %q, res = %+v", content.String(), res)
+	}
+	if reqs := mock.Requests(); len(reqs) != 1 || !reqs[0].Stream {
+		t.Errorf("requests = %+v", reqs)
+	}
+}
+
+func TestPredictLengthTruncated(t *testing.T) {
+	// content 为空时用推理内容作为分析
+	_, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Reasoning: "想了很久", FinishReason: "length"})
+	res, err := p.Predict(context.Background(), Request{Code: "hk00700"})
+	if err != nil || res.Analysis != "想了很久" || res.Verdict != nil {
+		t.Fatalf("res = %+v, err = %v", res, err)
+	}
+
+	_, p = newTestPredictor(t, &fakeStock{}, llmmock.Reply{FinishReason: "length"})
+	_, err = p.Predict(context.Background(), Request{Code: "hk00700"})
+	var ie *i18n.Error
+	if !errors.As(err, &ie) || ie.Key != i18n.MsgEmptyAnswer {
+		t.Errorf("err = %v, want %s", err, i18n.MsgEmptyAnswer)
+	}
+}
+
+func TestPredictQuoteFailed(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{quoteErr: fmt.Errorf("timeout")})
+	var types []string
+	_, err := p.StreamPredict(context.Background(), Request{Code: "hk00700"}, func(ev Event) error {
+		types = append(types, ev.Type)
+		return nil
+	})
+	var ie *i18n.Error
+	if !errors.As(err, &ie) || ie.Key != i18n.MsgDataQuoteFailed {
+		t.Errorf("err = %v, want %s", err, i18n.MsgDataQuoteFailed)
+	}
+	if len(types) != 1 || types[0] != EventContext {
+		t.Errorf("events = %v, want only context", types)
+	}
+	if n := len(mock.Requests()); n != 0 {
+		t.Errorf("LLM called %d times for a refused prediction", n)
+	}
+}
+
+func TestStreamPredictCancel(t *testing.T) {
+	_, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer, ChunkDelayMs: 100})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var chunks int
+	_, err := p.StreamPredict(ctx, Request{Code: "hk00700"}, func(ev Event) error {
+		if ev.Type == EventContent {
+			if chunks++; chunks == 2 {
+				cancel()
+			}
+		}
+		if ev.Type == EventResult {
+			t.Error("result emitted after cancel")
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	// 被取消的调用按提示词与已收到内容估算用量；生成在后台单飞中结束后才记录
+	waitFlights(t, p)
+	total := p.Usage().Summary(1)[0].Total
+	if total.Calls != 1 || total.EstimatedCalls != 1 || total.PromptTokens == 0 || total.CompletionTokens == 0 {
+		t.Errorf("usage = %+v, want one estimated call", total)
+	}
+}
+
+func TestPredictRetryWait(t *testing.T) {
+	_, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Status: 503, Times: 1}, llmmock.Reply{Content: mockAnswer})
+	var waits []llm.WaitStatus
+	res, err := p.StreamPredict(context.Background(), Request{Code: "hk00700"}, func(ev Event) error {
+		if ev.Type == EventQueue {
+			waits = append(waits, ev.Data.(llm.WaitStatus))
+		}
+		return nil
+	})
+	if err != nil || res.Verdict == nil {
+		t.Fatalf("res = %+v, err = %v", res, err)
+	}
+	if len(waits) != 1 || waits[0].Reason != llm.WaitRetry {
+		t.Errorf("queue events = %+v, want one retry wait", waits)
+	}
+}
+
+func TestQuantPredictLanguage(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{})
+	for _, tt := range []struct{ lang, want string }{
+		{"", "腾讯控股（hk00700）量化信号分析"},
+		{"zh-HK", "腾讯控股（hk00700）量化訊號分析"},
+		{"en", "quant signal analysis"},
+	} {
+		res, err := p.Predict(context.Background(), Request{Code: "hk00700", Model: quant.ModelName, Language: tt.lang})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(res.Analysis, tt.want) || strings.Contains(res.Analysis, "%!") {
+			t.Errorf("lang=%q analysis = %s", tt.lang, res.Analysis)
+		}
+		if tt.lang == "en" {
+			for _, r := range strings.ReplaceAll(res.Analysis, "腾讯控股", "") { // 股票名称来自行情，不翻译
+				if unicode.Is(unicode.Han, r) {
+					t.Errorf("en analysis contains %q: %s", r, res.Analysis)
+					break
+				}
+			}
+		}
+	}
+	if n := len(mock.Requests()); n != 0 {
+		t.Errorf("quant model called the LLM %d times", n)
+	}
+}
+
+func TestBatchPredictLanguage(t *testing.T) {
+	_, p := newTestPredictor(t, &fakeStock{quoteErr: fmt.Errorf("timeout")})
+	_, err := p.BatchPredict(context.Background(), BatchRequest{Language: "en"}, nil)
+	if got := i18n.Localize(err, "en"); got != "codes must not be empty" {
+		t.Errorf("empty codes err = %q", got)
+	}
+	if _, err = p.BatchPredict(context.Background(), BatchRequest{Codes: []string{"hk00700"}, Language: "fr"}, nil); err == nil {
+		t.Error("unsupported language accepted")
+	}
+	d, err := p.BatchPredict(context.Background(), BatchRequest{Codes: []string{"hk00700", "hk09988"}, Language: "en"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Failed) != 2 || !strings.HasPrefix(d.Failed[0].Error, "could not load the real-time quote for hk00700") {
+		t.Errorf("failed = %+v, want English per-item errors", d.Failed)
+	}
+	if hasHan(d.Document) || strings.Contains(d.Document, "%!") {
+		t.Errorf("en document = %s", d.Document)
+	}
+}
+
+// hasHan 文本是否含汉字
+func hasHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLocalizedText(t *testing.T) {
+	res := &Result{Language: i18n.En, Verdict: &Verdict{Direction: DirectionBullish, Confidence: 0.6, ChangeLowPct: 1, ChangeHighPct: 3, PriceLow: 404, PriceHigh: 412},
+		Ensemble: &Ensemble{Agreement: 0.5, Votes: map[string]float64{DirectionBullish: 1.2}, Members: []*EnsembleMember{
+			{Model: "a", Weight: 1.2, Accuracy: 0.6, Samples: 10, Result: &Result{Verdict: &Verdict{Direction: DirectionBullish, Confidence: 0.7}}},
+			{Model: "b", Weight: 1, Error: "timeout"},
+		}}}
+	md := ensembleMarkdown(res)
+	if hasHan(md) || strings.Contains(md, "%!") || !strings.Contains(md, "Verdict: **bullish**") {
+		t.Errorf("en markdown = %s", md)
+	}
+
+	bars, _ := (&fakeStock{}).GetKline(context.Background(), &stock.GetKlineRequest{Limit: 120})
+	bands, err := quant.Simulate(bars.Klines, 3, 400, quant.MCConfigFromEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := (&Snapshot{Bands: bands}).bandsText(i18n.En); hasHan(text) || strings.Contains(text, "%!") {
+		t.Errorf("en bands = %s", text)
+	}
+}
+
+func TestBatchPredictEmitFailure(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer})
+	t.Setenv("AI_BATCH_CONCURRENCY", "1")
+	broken := errors.New("client gone")
+	codes := []string{"hk00700", "hk09988", "hk03690", "hk01810", "hk09618"}
+	_, err := p.BatchPredict(context.Background(), BatchRequest{Codes: codes}, func(Event) error { return broken })
+	if !errors.Is(err, broken) {
+		t.Fatalf("err = %v, want the emit error", err)
+	}
+	if n := len(mock.Requests()); n != 1 {
+		t.Errorf("llm calls = %d, want queued items cancelled after the first failed emit", n)
+	}
+}
+
+func TestEnsembleEmitFailure(t *testing.T) {
+	_, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: mockAnswer, DelayMs: 5000})
+	broken := errors.New("client gone")
+	start := time.Now()
+	// quant 先完成，其 model_done 写入失败后不再等待慢速的 LLM 模型
+	_, err := p.StreamPredict(context.Background(), Request{Code: "hk00700", Models: []string{"a", "b", quant.ModelName}}, func(ev Event) error {
+		if ev.Type == EventModelDone {
+			return broken
+		}
+		return nil
+	})
+	if !errors.Is(err, broken) {
+		t.Fatalf("err = %v, want the emit error", err)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("ensemble returned after %v, want remaining members cancelled", d)
+	}
+}
+
+func TestToolsUnsupportedFallback(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{},
+		llmmock.Reply{Status: 400, Error: "context length exceeded", Times: 1},
+		llmmock.Reply{Status: 400, Error: "tool_choice is not supported", Times: 1},
+		llmmock.Reply{Content: "ok"},
+	)
+	p.toolSteps = 4
+	msgs := []llm.Message{{Role: "user", Content: "q"}}
+	// 与工具无关的 400 直接返回，不禁用工具
+	if _, _, err := p.complete(context.Background(), "mock", msgs, true, nil); err == nil || !p.toolsEnabled("mock") {
+		t.Fatalf("err = %v, tools enabled = %v", err, p.toolsEnabled("mock"))
+	}
+	resp, _, err := p.complete(context.Background(), "mock", msgs, true, nil)
+	if err != nil || resp.Content != "ok" || p.toolsEnabled("mock") {
+		t.Fatalf("resp = %+v, err = %v, tools enabled = %v", resp, err, p.toolsEnabled("mock"))
+	}
+	if reqs := mock.Requests(); len(reqs) != 3 || len(reqs[1].Tools) == 0 || len(reqs[2].Tools) != 0 {
+		t.Errorf("requests = %d, want tools dropped on retry", len(reqs))
+	}
+	// 过期后重新启用
+	p.noToolsTTL = time.Minute
+	p.noTools.Store("mock", time.Now().Add(-2*time.Minute))
+	if !p.toolsEnabled("mock") {
+		t.Error("tools still disabled after TTL")
+	}
+}
+
+func TestDaysLimit(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{})
+	ctx := context.Background()
+	days := int32(quant.MaxDays + 1)
+	_, err := p.Predict(ctx, Request{Code: "hk00700", Days: days})
+	_, cerr := p.Compare(ctx, CompareRequest{Codes: []string{"hk00700", "hk09988"}, Days: days})
+	_, berr := p.BatchPredict(ctx, BatchRequest{Codes: []string{"hk00700"}, Days: days}, nil)
+	for _, err := range []error{err, cerr, berr} {
+		var ie *i18n.Error
+		if !errors.As(err, &ie) || ie.Key != i18n.MsgDaysOutOfRange {
+			t.Errorf("err = %v, want %s", err, i18n.MsgDaysOutOfRange)
+		}
+	}
+	if n := len(mock.Requests()); n != 0 {
+		t.Errorf("LLM called %d times", n)
+	}
+}
+
+func TestMarketCommentaryCache(t *testing.T) {
+	mock, p := newTestPredictor(t, &fakeStock{}, llmmock.Reply{Content: "大市窄幅上落。"})
+	ctx := context.Background()
+	first, err := p.MarketCommentary(ctx, MarketCommentaryRequest{})
+	if err != nil || first.Commentary != "大市窄幅上落。" || first.Cached {
+		t.Fatalf("first = %+v, err = %v", first, err)
+	}
+	var types []string
+	again, err := p.StreamMarketCommentary(ctx, MarketCommentaryRequest{}, func(ev Event) error {
+		types = append(types, ev.Type)
+		return nil
+	})
+	if err != nil || !again.Cached || len(mock.Requests()) != 1 {
+		t.Errorf("second cached=%v err=%v requests=%d", again != nil && again.Cached, err, len(mock.Requests()))
+	}
+	if len(types) == 0 || types[0] != EventOverview || types[len(types)-1] != EventCommentary {
+		t.Errorf("replayed events = %v", types)
+	}
+	if _, err := p.MarketCommentary(ctx, MarketCommentaryRequest{ForceRefresh: true}); err != nil || len(mock.Requests()) != 2 {
+		t.Errorf("force refresh err=%v requests=%d", err, len(mock.Requests()))
+	}
+
+	_, p = newTestPredictor(t, &fakeStock{noOverview: true})
+	if _, err := p.MarketCommentary(ctx, MarketCommentaryRequest{Language: "en"}); err == nil {
+		t.Error("nil overview accepted")
+	}
+}
@@ -0,0 +1,104 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+)
+
+// promptData 模板变量（模板中以 {{.Stock}} 等引用）：各数据块与时段相关文案
+type promptData struct {
+	Code            string
+	Days            int32
+	Now             string
+	TradingStatus   string
+	PredictionFocus string
+	TimeInstruction string
+	Stock           string
+	Market          string
+	Technical       string
+	Bands           string // [统计区间] 蒙特卡洛价格分位数
+	Tools           string // 可调用的工具名，为空表示本次不启用工具
+	ToolSteps       int
+	Bull            string // 辩论模式：多方观点（仅裁判模板使用）
+	Bear            string // 辩论模式：空方观点（仅裁判模板使用）
+	Language        string // 输出语言（i18n.ZhCN 等）
+	Overview        string // 市场评论：市场宽度、涨跌幅与资金排行、南向资金（仅市场评论模板使用）
+	Stocks          string // 多股对比：各股的实时数据、技术面与统计区间（仅对比模板使用）
+}
+
+// timePhrases 与交易时段相关的模板文案（盘中 / 休市），Focus 以预测天数格式化
+type timePhrases struct {
+	Status, Focus, Instruction string
+}
+
+// phrases 各语言的时段文案：[0] 休市，[1] 盘中
+var phrases = map[string][2]timePhrases{
+	i18n.ZhCN: {
+		{"港股休市", "未来 1 个交易日及未来 %d 天走势", "- 当前状态：港股休市（盘后/周末）\n- 重点：结合全日表现与大盘环境，给出下一交易日及未来数日的展望。"},
+		{"港股盘中（9:30-12:00, 13:00-16:00 香港时间）", "今日收盘走势及未来 %d 天", "- 当前状态：港股盘中交易中\n- 重点：结合实时价格、涨跌幅、成交量与大盘联动，判断尾盘及短期方向。"},
+	},
+	i18n.ZhTW: {
+		{"港股休市", "未來 1 個交易日及未來 %d 天走勢", "- 當前狀態：港股休市（收市後/週末）\n- 重點：結合全日表現與大市環境，給出下一交易日及未來數日的展望。"},
+		{"港股交易時段（9:30-12:00, 13:00-16:00 香港時間）", "今日收市走勢及未來 %d 天", "- 當前狀態：港股交易中\n- 重點：結合即時價格、升跌幅、成交量與大市聯動，判斷尾市及短期方向。"},
+	},
+	i18n.En: {
+		{"HK market closed", "the next trading day and the next %d days", "- Status: the Hong Kong market is closed (after hours / weekend)\n- Focus: use the full-day performance and the broader market to give an outlook for the next trading day and the following days."},
+		{"HK market open (9:30-12:00, 13:00-16:00 HKT)", "today's close and the next %d days", "- Status: the Hong Kong market is trading\n- Focus: use the live price, change, volume and the broader market to judge the direction into the close and the short term."},
+	},
+}
+
+// languageInstructions 所选模板版本没有对应译本时附加在 prompt 末尾的输出语言要求
+var languageInstructions = map[string]string{
+	i18n.ZhTW: "\n\n請以繁體中文（香港用語）撰寫全部回答；JSON 的欄位名稱與 direction 取值保持英文不變。",
+	i18n.En:   "\n\nWrite the entire answer in English; keep the JSON field names and direction values exactly as specified.",
+}
+
+// newPromptData 由上下文快照生成模板变量（时段文案按 lang）；模板可引用 promptData 的全部字段。
+func newPromptData(snap *Snapshot, lang string) promptData {
+	set, ok := phrases[lang]
+	if !ok {
+		lang, set = i18n.ZhCN, phrases[i18n.ZhCN]
+	}
+	ph := set[0]
+	if snap.IsTrading {
+		ph = set[1]
+	}
+	return promptData{
+		Code:            snap.Code,
+		Days:            snap.Days,
+		Now:             snap.Time.Format("2006-01-02 15:04:05"),
+		TradingStatus:   ph.Status,
+		PredictionFocus: fmt.Sprintf(ph.Focus, snap.Days),
+		TimeInstruction: ph.Instruction,
+		Stock:           snap.Stock,
+		Market:          snap.Market,
+		Technical:       snap.TechnicalText,
+		Bands:           snap.bandsText(lang),
+		Language:        lang,
+	}
+}
+
+// ask 按 data.Language 选择模板译本、渲染并调用 LLM（useTools 时允许模型调用工具），返回回答、工具调用记录与模板 id@version。
+// 所选版本没有该语言译本时使用简体中文模板并附加输出语言要求。
+func (p *Predictor) ask(ctx context.Context, model, templateID, version string, data promptData, useTools bool, emit EventFunc) (*llm.Response, []ToolInvocation, string, error) {
+	tmpl, err := p.prompts.SelectLang(templateID, version, data.Language)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if useTools {
+		data.Tools, data.ToolSteps = strings.Join(p.tools.Names(), "、"), p.toolSteps
+	}
+	text, err := tmpl.Render(data)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if tmpl.Lang == "" && data.Language != i18n.ZhCN {
+		text += languageInstructions[data.Language]
+	}
+	resp, calls, err := p.complete(ctx, model, []llm.Message{{Role: "user", Content: text}}, useTools, emit)
+	return resp, calls, tmpl.Key(), err
+}
@@ -0,0 +1,57 @@
+package predictor
+
+import (
+	"context"
+	"log"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+)
+
+// quantPredict 规则量化模型预测：基于快照中的日 K 与恒指日 K 计算信号，输出与 LLM 预测相同结构的结果并写入预测记录。
+// 恒指 K 线获取失败时不计相对强弱；历史快照使用其自带的恒指 K 线且不写预测记录。
+func (p *Predictor) quantPredict(ctx context.Context, req Request, snap *Snapshot) (*Result, error) {
+	if len(snap.Bars) == 0 {
+		return nil, i18n.Errorf(i18n.MsgQuantNeedsKline, snap.TechnicalText)
+	}
+	index := snap.IndexBars
+	if !snap.Historical {
+		var err error
+		if index, err = p.fetchKline(ctx, quant.IndexSecID); err != nil {
+			log.Printf("[Predict] fetch index kline for quant: %v", err)
+		}
+	}
+	price, name := 0.0, ""
+	if q := snap.Quote; q != nil {
+		price, name = q.CurrentPrice, q.Name
+	}
+	f, err := quant.Predict(snap.Bars, index, int(req.Days), price, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{
+		Code:        req.Code,
+		Model:       quant.ModelName,
+		Mode:        ModeSingle,
+		Analysis:    f.Markdown(req.Code, name, req.Language),
+		Confidence:  f.Confidence,
+		NewsSummary: i18n.T(req.Language, i18n.MsgNewsQuant),
+		Language:    req.Language,
+		Verdict: &Verdict{
+			Direction:     f.Direction,
+			Confidence:    f.Confidence,
+			ChangeLowPct:  f.ChangeLowPct,
+			ChangeHighPct: f.ChangeHighPct,
+			PriceLow:      f.PriceLow,
+			PriceHigh:     f.PriceHigh,
+		},
+		Technical: snap.Technical,
+		Bands:     snap.Bands,
+	}
+	p.calibrate(res)
+	if !snap.Historical {
+		p.record(snap, res)
+	}
+	log.Printf("[Predict] quant code=%s direction=%s score=%.2f confidence=%.2f", req.Code, f.Direction, f.Score, f.Confidence)
+	return res, nil
+}
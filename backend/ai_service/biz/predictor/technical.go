@@ -0,0 +1,124 @@
+package predictor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"hk_stock_assistant/backend/stock_service/biz/indicator"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// technicalBars 计算技术指标所需的日 K 数量（MA60 + MACD 预热）
+const technicalBars = 120
+
+// Technical 日线技术指标快照（取最后一根 K 线的值）。样本不足的指标为 0。
+type Technical struct {
+	AsOf        string  `json:"as_of"`
+	Bars        int     `json:"bars"`
+	Close       float64 `json:"close"`
+	MA5         float64 `json:"ma5"`
+	MA10        float64 `json:"ma10"`
+	MA20        float64 `json:"ma20"`
+	MA60        float64 `json:"ma60"`
+	MACDDif     float64 `json:"macd_dif"`
+	MACDDea     float64 `json:"macd_dea"`
+	MACDHist    float64 `json:"macd_hist"`
+	RSI6        float64 `json:"rsi6"`
+	RSI12       float64 `json:"rsi12"`
+	RSI24       float64 `json:"rsi24"`
+	KDJK        float64 `json:"kdj_k"`
+	KDJD        float64 `json:"kdj_d"`
+	KDJJ        float64 `json:"kdj_j"`
+	BollUpper   float64 `json:"boll_upper"`
+	BollMid     float64 `json:"boll_mid"`
+	BollLower   float64 `json:"boll_lower"`
+	VolumeRatio float64 `json:"volume_ratio"`
+	High20      float64 `json:"high20"`
+	Low20       float64 `json:"low20"`
+}
+
+// fetchKline 预拉取近期日 K（按日期升序）。
+func (p *Predictor) fetchKline(ctx context.Context, code string) ([]*stock.KLine, error) {
+	rpcResp, err := p.stockClient.GetKline(ctx, &stock.GetKlineRequest{Code: code, Period: "day", Limit: technicalBars})
+	if err != nil {
+		return nil, err
+	}
+	if rpcResp == nil || len(rpcResp.Klines) == 0 {
+		return nil, fmt.Errorf("无 K 线数据")
+	}
+	return rpcResp.Klines, nil
+}
+
+// fetchTechnical 拉取日 K 并计算技术指标，返回 (日 K, 指标, prompt 文本, 失败原因)。失败时日 K 与指标为 nil。
+func (p *Predictor) fetchTechnical(ctx context.Context, code string) ([]*stock.KLine, *Technical, string, error) {
+	bars, err := p.fetchKline(ctx, code)
+	if err != nil {
+		return nil, nil, fmt.Sprintf("获取K线失败: %v", err), err
+	}
+	t := computeTechnical(bars)
+	return bars, t, t.String(), nil
+}
+
+// computeTechnical 基于 indicator 库按常用参数计算：MA5/10/20/60、MACD(12,26,9)、RSI6/12/24、KDJ(9,3,3)、BOLL(20,2)、量比(5)。
+func computeTechnical(klines []*stock.KLine) *Technical {
+	bars := indicator.FromKLines(klines)
+	n := len(bars)
+	t := &Technical{Bars: n}
+	if n == 0 {
+		return t
+	}
+	last := bars[n-1]
+	t.AsOf = last.Date
+	t.Close = last.Close
+
+	res, _ := indicator.Compute(bars, []string{"ma", "macd", "rsi", "kdj", "boll", "vol_ratio"})
+	v := func(name, key string) float64 {
+		x := res[name][key].Last()
+		if math.IsNaN(x) {
+			return 0
+		}
+		return x
+	}
+	t.MA5, t.MA10, t.MA20, t.MA60 = v("ma", "ma5"), v("ma", "ma10"), v("ma", "ma20"), v("ma", "ma60")
+	t.MACDDif, t.MACDDea, t.MACDHist = v("macd", "dif"), v("macd", "dea"), v("macd", "hist")
+	t.RSI6, t.RSI12, t.RSI24 = v("rsi", "rsi6"), v("rsi", "rsi12"), v("rsi", "rsi24")
+	t.KDJK, t.KDJD, t.KDJJ = v("kdj", "k"), v("kdj", "d"), v("kdj", "j")
+	t.BollUpper, t.BollMid, t.BollLower = v("boll", "upper"), v("boll", "mid"), v("boll", "lower")
+	t.VolumeRatio = v("vol_ratio", "vol_ratio")
+
+	// 20 日最高/最低
+	if n >= 20 {
+		t.High20, t.Low20 = bars[n-20].High, bars[n-20].Low
+		for _, b := range bars[n-19:] {
+			t.High20 = math.Max(t.High20, b.High)
+			t.Low20 = math.Min(t.Low20, b.Low)
+		}
+	}
+	return t
+}
+
+// String 返回 prompt 中 [技术面] 块的内容；样本不足的指标显示为 —。
+func (t *Technical) String() string {
+	if t == nil || t.Bars == 0 {
+		return "无K线数据"
+	}
+	f := func(need int, v float64) string {
+		if t.Bars < need {
+			return "—"
+		}
+		return fmt.Sprintf("%.2f", v)
+	}
+	lines := []string{
+		fmt.Sprintf("日线截至 %s（共 %d 根），收盘=%.2f", t.AsOf, t.Bars, t.Close),
+		fmt.Sprintf("均线: MA5=%s, MA10=%s, MA20=%s, MA60=%s", f(5, t.MA5), f(10, t.MA10), f(20, t.MA20), f(60, t.MA60)),
+		fmt.Sprintf("MACD(12,26,9): DIF=%s, DEA=%s, 柱=%s", f(26, t.MACDDif), f(26, t.MACDDea), f(26, t.MACDHist)),
+		fmt.Sprintf("RSI: RSI6=%s, RSI12=%s, RSI24=%s", f(7, t.RSI6), f(13, t.RSI12), f(25, t.RSI24)),
+		fmt.Sprintf("KDJ(9,3,3): K=%s, D=%s, J=%s", f(9, t.KDJK), f(9, t.KDJD), f(9, t.KDJJ)),
+		fmt.Sprintf("BOLL(20,2): 上轨=%s, 中轨=%s, 下轨=%s", f(20, t.BollUpper), f(20, t.BollMid), f(20, t.BollLower)),
+		fmt.Sprintf("量比(对前5日均量)=%s", f(6, t.VolumeRatio)),
+		fmt.Sprintf("20日区间: 最高=%s, 最低=%s", f(20, t.High20), f(20, t.Low20)),
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,329 @@
+package predictor
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/metrics"
+)
+
+// 数字核对的告警类型
+const (
+	WarningPrice       = "price"             // 引用的现价与行情不符
+	WarningChange      = "change_pct"        // 引用的当日涨跌幅与行情不符
+	WarningIndex       = "index"             // 引用的恒指点位与大盘数据不符
+	WarningVolume      = "volume"            // 引用的成交量与行情不符
+	WarningUnsupported = "unsupported_price" // 输入数据中找不到依据且明显偏离现价的价格
+	WarningRange       = "range"             // 结论中的预计区间自相矛盾或不合理
+	WarningData        = "data"              // 非关键输入数据获取失败，分析未参考该项数据
+)
+
+// Warning 数字核对发现的不一致：回答中引用的数字与输入数据快照不符，或结论区间不合理
+type Warning struct {
+	Kind     string  `json:"kind"`
+	Message  string  `json:"message"`
+	Cited    float64 `json:"cited,omitempty"`    // 回答中的数值
+	Expected float64 `json:"expected,omitempty"` // 数据快照中的数值
+	Model    string  `json:"model,omitempty"`    // 多模型对比时产生告警的模型
+}
+
+func init() {
+	metrics.Describe("ai_verification_warnings_total", "预测回答数字核对告警数，按类型统计")
+}
+
+// 核对容差
+const (
+	priceTolerance   = 0.01 // 现价相对误差
+	changeTolerance  = 0.3  // 涨跌幅绝对误差（百分点）
+	indexTolerance   = 0.01 // 指数点位相对误差
+	volumeTolerance  = 0.05 // 成交量相对误差
+	knownTolerance   = 0.005
+	rangeMismatchPct = 1.5  // 价格区间换算的涨跌幅与给出的涨跌幅之差（百分点）
+	maxMovePct       = 30.0 // 预计涨跌幅绝对值上限
+)
+
+const numPattern = `([+-]?\d{1,3}(?:,\d{3})+(?:\.\d+)?|[+-]?\d+(?:\.\d+)?)`
+
+// 引用的识别兼顾简体、繁体与英文用语
+var (
+	citedPriceRe = regexp.MustCompile(`(?i)([现現][价價]|最新股?[价價]|[当當]前股?[价價]|[现現][报報]|收[报報]|\b(?:current|last|latest|share) price|\btrad(?:ing|ed) at|\bclosed at)` +
+		`[^\d\n+-]{0,12}?` + numPattern)
+	citedChangeRe = regexp.MustCompile(`(?i)(今日|今天|[当當]日|日[内內]|\btoday'?s|\bintraday|\bdaily|\bon the day)?\s*` +
+		`([涨漲]跌幅|[涨漲]幅|跌幅|\b(?:change|gain|rise|decline|drop|fall|loss)\b)[^\d\n+-]{0,8}?` + numPattern + `\s*%`)
+	citedIndexRe  = regexp.MustCompile(`(?i)(恒生指[数數]|恒指|\bhang seng(?: index)?|\bhsi\b)[^\d\n+-]{0,12}?` + numPattern)
+	citedVolumeRe = regexp.MustCompile(`(?i)(?:成交量|\bvolume\b)[^\d\n+-]{0,8}?` + numPattern + `\s*([万萬]|[亿億]|(?:million|billion|k)\b)?`)
+	unitPriceRe   = regexp.MustCompile(numPattern + `\s*(?:港元|港[币幣]|元|HKD)|(?:HK\$|HKD)\s*` + numPattern)
+	anyNumberRe   = regexp.MustCompile(numPattern)
+)
+
+// forecastWords 出现在数字前不远处时，该数字为预测而非对现状的引用；forecastWordRe 为英文用语，回看范围更大
+var (
+	forecastWords  = []string{"预计", "預計", "预期", "預期", "目标", "目標", "未来", "未來", "区间", "區間", "或将", "或將", "有望"}
+	forecastWordRe = regexp.MustCompile(`(?i)\b(?:expect|target|forecast|project|outlook|could|may|might|will|likely|range|next)`)
+	periodWordRe   = regexp.MustCompile(`(?i)\d[- ]?(?:day|week|month|year)|\b(?:weekly|monthly|yearly|annual)`)
+)
+
+// volumeUnits 成交量单位对应的倍数
+var volumeUnits = map[string]float64{"万": 1e4, "萬": 1e4, "亿": 1e8, "億": 1e8, "k": 1e3, "million": 1e6, "billion": 1e9}
+
+// changeSign 涨跌幅用语的方向：涨跌幅类为 0（引用值按绝对值比较），跌幅类为 -1，涨幅类为 1
+func changeSign(kw string) int {
+	switch strings.ToLower(kw) {
+	case "涨跌幅", "漲跌幅", "change":
+		return 0
+	case "跌幅", "decline", "drop", "fall", "loss":
+		return -1
+	}
+	return 1
+}
+
+// verifyNumbers 核对回答中引用的现价、当日涨跌幅、恒指点位与成交量是否与数据快照一致，
+// 检查明显偏离现价且在输入数据（含工具结果）中找不到依据的价格，以及结论区间是否自洽、是否远超统计区间。
+// 告警文案按 res.Language 输出。
+func verifyNumbers(snap *Snapshot, res *Result) []Warning {
+	var ws []Warning
+	lang := res.Language
+	add := func(kind string, cited, expected float64, msg string) {
+		for _, w := range ws {
+			if w.Message == msg {
+				return
+			}
+		}
+		ws = append(ws, Warning{Kind: kind, Message: msg, Cited: cited, Expected: expected})
+	}
+	text := res.Analysis
+	price := 0.0
+	if q := snap.Quote; q != nil {
+		price = q.CurrentPrice
+		for _, m := range citations(text, citedPriceRe, 2) {
+			if relDiff(m.value, price) > priceTolerance {
+				add(WarningPrice, m.value, price, i18n.T(lang, i18n.MsgVerifyPrice, m.raw, price))
+			}
+		}
+		for _, m := range citations(text, citedChangeRe, 3) {
+			kw := text[m.sub[4]:m.sub[5]]
+			sign := changeSign(kw)
+			if m.sub[2] < 0 && (sign != 0 || periodBefore(text, m.sub[0])) {
+				continue // 未限定“今日”的涨幅/跌幅多为区间或预测，“5 日涨跌幅”等为区间涨跌
+			}
+			want := q.ChangePercent
+			got := m.value
+			if !strings.ContainsAny(m.raw, "+-") {
+				if sign < 0 {
+					got = -got
+				} else if sign == 0 {
+					got, want = math.Abs(got), math.Abs(want)
+				}
+			}
+			if math.Abs(got-want) > changeTolerance {
+				add(WarningChange, m.value, q.ChangePercent, i18n.T(lang, i18n.MsgVerifyChange, kw, m.raw, q.ChangePercent))
+			}
+		}
+		if q.Volume > 0 {
+			for _, m := range citations(text, citedVolumeRe, 1) {
+				if strings.Contains(strings.ToLower(text[m.sub[0]:m.sub[2]]), "ratio") {
+					continue // volume ratio 为量比
+				}
+				v := m.value
+				if m.sub[4] >= 0 {
+					v *= volumeUnits[strings.ToLower(text[m.sub[4]:m.sub[5]])]
+				}
+				if relDiff(v, float64(q.Volume)) > volumeTolerance {
+					add(WarningVolume, v, float64(q.Volume), i18n.T(lang, i18n.MsgVerifyVolume, strings.TrimSpace(text[m.sub[2]:m.sub[1]]), q.Volume))
+				}
+			}
+		}
+	}
+	for _, idx := range snap.Indices {
+		if idx == nil || idx.Name != "恒生指数" || idx.Value <= 0 {
+			continue
+		}
+		for _, m := range citations(text, citedIndexRe, 2) {
+			if m.value >= 1000 && relDiff(m.value, idx.Value) > indexTolerance {
+				add(WarningIndex, m.value, idx.Value, i18n.T(lang, i18n.MsgVerifyIndex, m.raw, idx.Value))
+			}
+		}
+	}
+
+	if price > 0 {
+		known := knownNumbers(snap, res.ToolCalls)
+		for _, sub := range unitPriceRe.FindAllStringSubmatchIndex(text, -1) {
+			i := 2
+			if sub[2] < 0 {
+				i = 4
+			}
+			raw := text[sub[i]:sub[i+1]]
+			v, ok := parseNumber(raw)
+			if !ok || v <= 0 || (v >= price*0.5 && v <= price*1.5) || known.has(v) {
+				continue
+			}
+			add(WarningUnsupported, v, price, i18n.T(lang, i18n.MsgVerifyUnsupported, raw, price))
+		}
+	}
+
+	for _, w := range verifyRange(snap, res.Verdict, price, lang) {
+		add(WarningRange, 0, 0, w)
+	}
+	for _, w := range ws {
+		metrics.Inc("ai_verification_warnings_total", "kind", w.Kind)
+	}
+	return ws
+}
+
+// verifyRange 结论区间的合理性：上下限顺序、价格区间与涨跌幅区间是否一致、方向与区间是否矛盾、幅度与统计区间，
+// 返回按 lang 输出的告警文案
+func verifyRange(snap *Snapshot, v *Verdict, price float64, lang string) []string {
+	if v == nil {
+		return nil
+	}
+	var out []string
+	add := func(key string, args ...interface{}) { out = append(out, i18n.T(lang, key, args...)) }
+	if v.ChangeLowPct > v.ChangeHighPct {
+		add(i18n.MsgRangeChangeOrder, v.ChangeLowPct, v.ChangeHighPct)
+	}
+	if v.PriceLow > 0 && v.PriceHigh > 0 && v.PriceLow > v.PriceHigh {
+		add(i18n.MsgRangePriceOrder, v.PriceLow, v.PriceHigh)
+	}
+	hasChange := v.ChangeLowPct != 0 || v.ChangeHighPct != 0
+	if hasChange {
+		switch {
+		case v.Direction == DirectionBullish && v.ChangeHighPct <= 0:
+			add(i18n.MsgRangeBullish, v.ChangeHighPct)
+		case v.Direction == DirectionBearish && v.ChangeLowPct >= 0:
+			add(i18n.MsgRangeBearish, v.ChangeLowPct)
+		}
+		if math.Abs(v.ChangeLowPct) > maxMovePct || math.Abs(v.ChangeHighPct) > maxMovePct {
+			add(i18n.MsgRangeTooWide, v.ChangeLowPct, v.ChangeHighPct, maxMovePct)
+		}
+	}
+	if price > 0 && v.PriceLow > 0 && v.PriceHigh > 0 {
+		low, high := (v.PriceLow/price-1)*100, (v.PriceHigh/price-1)*100
+		if hasChange && (math.Abs(low-v.ChangeLowPct) > rangeMismatchPct || math.Abs(high-v.ChangeHighPct) > rangeMismatchPct) {
+			add(i18n.MsgRangeMismatch, v.PriceLow, v.PriceHigh, price, low, high, v.ChangeLowPct, v.ChangeHighPct)
+		}
+		if b := snap.Bands; b != nil && len(b.Points) > 0 {
+			last := b.Points[len(b.Points)-1]
+			if margin := last.P95 - last.P5; margin > 0 && (v.PriceHigh > last.P95+margin || v.PriceLow < last.P5-margin) {
+				add(i18n.MsgRangeBeyondBands, v.PriceLow, v.PriceHigh, last.Day, last.P5, last.P95)
+			}
+		}
+	}
+	return out
+}
+
+// citation 回答中匹配到的一处引用：sub 为正则子匹配下标，value 为第 group 组的数值
+type citation struct {
+	sub   []int
+	raw   string
+	value float64
+}
+
+// citations 匹配 re 的引用，跳过预测语境与紧跟 %、“倍”或 x 的数值（group 为数值所在子组序号）；re 末尾已要求 % 的不受后者限制
+func citations(text string, re *regexp.Regexp, group int) []citation {
+	var out []citation
+	wantPct := strings.HasSuffix(re.String(), `%`)
+	for _, sub := range re.FindAllStringSubmatchIndex(text, -1) {
+		s, e := sub[2*group], sub[2*group+1]
+		if s < 0 {
+			continue
+		}
+		if rest := strings.TrimSpace(text[e:]); !wantPct && (strings.HasPrefix(rest, "%") || strings.HasPrefix(rest, "倍") || strings.HasPrefix(rest, "x")) {
+			continue
+		}
+		if forecastContext(text, sub[0]) {
+			continue
+		}
+		v, ok := parseNumber(text[s:e])
+		if !ok {
+			continue
+		}
+		out = append(out, citation{sub: sub, raw: text[s:e], value: v})
+	}
+	return out
+}
+
+// forecastContext 匹配位置之前同一句内是否出现预测用语：中文 12 个字符以内，英文 32 个字符以内
+func forecastContext(text string, pos int) bool {
+	before := []rune(text[:pos])
+	if len(before) > 32 {
+		before = before[len(before)-32:]
+	}
+	s := string(before)
+	if i := strings.LastIndexAny(s, sentenceEnd+"；;"); i >= 0 {
+		s = s[i:]
+	}
+	if i := strings.LastIndex(s, ". "); i >= 0 {
+		s = s[i:]
+	}
+	if forecastWordRe.MatchString(s) {
+		return true
+	}
+	if r := []rune(s); len(r) > 12 {
+		s = string(r[len(r)-12:])
+	}
+	for _, w := range forecastWords {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// periodBefore 匹配位置紧前是否有区间用语：4 个字符内的日、周、月、年，或 12 个字符内的 5-day、weekly 等
+func periodBefore(text string, pos int) bool {
+	before := []rune(text[:pos])
+	if len(before) > 12 {
+		before = before[len(before)-12:]
+	}
+	if periodWordRe.MatchString(string(before)) {
+		return true
+	}
+	if len(before) > 4 {
+		before = before[len(before)-4:]
+	}
+	return strings.ContainsAny(string(before), "日周週月年")
+}
+
+func parseNumber(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	return v, err == nil
+}
+
+func relDiff(a, b float64) float64 {
+	if b == 0 {
+		return math.Abs(a)
+	}
+	return math.Abs(a-b) / math.Abs(b)
+}
+
+// numberSet 输入数据中出现过的数值
+type numberSet []float64
+
+func (s numberSet) has(v float64) bool {
+	for _, k := range s {
+		if relDiff(v, k) <= knownTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// knownNumbers 数据快照文本与工具调用结果中出现的全部数值
+func knownNumbers(snap *Snapshot, calls []ToolInvocation) numberSet {
+	texts := []string{snap.ContextText()}
+	for _, c := range calls {
+		texts = append(texts, c.Result)
+	}
+	var out numberSet
+	for _, t := range texts {
+		for _, raw := range anyNumberRe.FindAllString(t, -1) {
+			if v, ok := parseNumber(raw); ok && v != 0 {
+				out = append(out, math.Abs(v))
+			}
+		}
+	}
+	return out
+}
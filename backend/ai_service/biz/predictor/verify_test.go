@@ -0,0 +1,190 @@
+package predictor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// verifySnapshot 现价 400、涨跌幅 +1.5%、成交量 100 万、恒指 20000 的数据快照
+func verifySnapshot() *Snapshot {
+	return &Snapshot{
+		Quote:   &stock.StockInfo{CurrentPrice: 400, ChangePercent: 1.5, Volume: 1000000},
+		Indices: []*stock.MarketIndex{{Name: "恒生指数", Value: 20000}},
+		Stock:   "现价 400.000，52 周低 180.200",
+	}
+}
+
+func TestVerifyNumbers(t *testing.T) {
+	for _, tt := range []struct {
+		name, text string
+		want       []string // 告警类型，按出现顺序
+	}{
+		{"matching figures", "腾讯现价 400.00，今日涨跌幅 +1.5%，成交量 100 万股，恒指 20000 点。", nil},
+		{"wrong price", "腾讯现价 420，走势偏强。", []string{WarningPrice}},
+		{"price within tolerance", "最新价 401.2 港元。", nil},
+		{"price followed by percent", "现价较高点回落 5%。", nil},
+		{"wrong change", "今日涨幅 3.2%。", []string{WarningChange}},
+		{"decline sign", "当日跌幅 1.5%。", []string{WarningChange}},
+		{"unsigned change compared by magnitude", "涨跌幅 1.5%。", nil},
+		{"period change skipped", "5 日涨跌幅 8%。", nil},
+		{"unqualified gain skipped", "涨幅 8% 后回调。", nil},
+		{"forecast skipped", "预计未来三日涨跌幅 5%，现价目标 450。", nil},
+		{"wrong volume in wan", "成交量 300 万股。", []string{WarningVolume}},
+		{"volume in yi", "成交量 1 亿股。", []string{WarningVolume}},
+		{"wrong index", "恒指报 21000 点。", []string{WarningIndex}},
+		{"small number after index skipped", "恒指 5 日上涨。", nil},
+		{"unsupported price", "支撑位 150 港元。", []string{WarningUnsupported}},
+		{"unsupported price in HK$", "Support at HK$150.", []string{WarningUnsupported}},
+		{"price found in input data", "52 周低位 180.2 港元附近有支撑。", nil},
+		{"price near current", "支撑 380 港元。", nil},
+		{"english wrong price", "The current price of HK$420 looks stretched.", []string{WarningPrice}},
+		{"english matching change", "Today's change of 1.5% is modest.", nil},
+		{"english period change skipped", "The 5-day change of 8% is strong.", nil},
+		{"english forecast skipped", "We expect the current price to reach 450 next week.", nil},
+		{"english volume ratio skipped", "Volume ratio of 2.5x signals interest.", nil},
+		{"traditional wrong price", "現價 420，當日漲幅 1.5%。", []string{WarningPrice}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, w := range verifyNumbers(verifySnapshot(), &Result{Analysis: tt.text}) {
+				got = append(got, w.Kind)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("%q: warnings = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyLanguage(t *testing.T) {
+	snap := verifySnapshot()
+	for _, tt := range []struct {
+		lang, text string
+		kinds      []string
+	}{
+		{i18n.ZhTW, "騰訊現價 420，當日漲幅 3.5%，恒生指數 21000，成交量 200萬。", []string{WarningPrice, WarningChange, WarningVolume, WarningIndex}},
+		{i18n.En, "The current price is HK$420 and today's gain of 3.5% lifted volume to 2 million while the Hang Seng Index sits at 21,000.",
+			[]string{WarningPrice, WarningChange, WarningVolume, WarningIndex}},
+		{i18n.En, "The current price is 400.0, a daily change of 1.5% on volume of 1 million shares. We expect a target price of 420.", nil},
+	} {
+		ws := verifyNumbers(snap, &Result{Analysis: tt.text, Language: tt.lang,
+			Verdict: &Verdict{Direction: DirectionBullish, ChangeLowPct: 1, ChangeHighPct: -1}})
+		var kinds []string
+		for _, w := range ws {
+			if w.Kind != WarningRange {
+				kinds = append(kinds, w.Kind)
+			}
+			if tt.lang == i18n.En && hasHan(w.Message) {
+				t.Errorf("en warning %q", w.Message)
+			}
+		}
+		if fmt.Sprint(kinds) != fmt.Sprint(tt.kinds) || len(ws) != len(kinds)+2 {
+			t.Errorf("%q: warnings = %+v, want kinds %v plus 2 range warnings", tt.text, ws, tt.kinds)
+		}
+	}
+}
+
+func TestVerifyRange(t *testing.T) {
+	bands := &quant.Bands{Points: []quant.BandPoint{{Day: 3, P5: 380, P95: 420}}}
+	for _, tt := range []struct {
+		name  string
+		v     *Verdict
+		price float64
+		want  []string // 各告警须包含的文字，按顺序
+	}{
+		{"nil verdict", nil, 400, nil},
+		{"consistent", &Verdict{Direction: DirectionBullish, ChangeLowPct: 1, ChangeHighPct: 3, PriceLow: 404, PriceHigh: 412}, 400, nil},
+		{"no change range", &Verdict{Direction: DirectionBullish, PriceLow: 404, PriceHigh: 412}, 400, nil},
+		{"change bounds swapped", &Verdict{Direction: DirectionNeutral, ChangeLowPct: 2, ChangeHighPct: -2}, 400, []string{"下限 +2.00% 高于上限 -2.00%"}},
+		{"price bounds swapped", &Verdict{PriceLow: 410, PriceHigh: 390}, 0, []string{"下限 410.000 高于上限 390.000"}},
+		{"bullish below zero", &Verdict{Direction: DirectionBullish, ChangeLowPct: -3, ChangeHighPct: -1}, 0, []string{"结论看多"}},
+		{"bearish above zero", &Verdict{Direction: DirectionBearish, ChangeLowPct: 0, ChangeHighPct: 2}, 0, []string{"结论看空"}},
+		{"too wide", &Verdict{Direction: DirectionBullish, ChangeLowPct: -5, ChangeHighPct: 40}, 0, []string{"超出 ±30%"}},
+		{"price and change mismatch", &Verdict{Direction: DirectionBullish, ChangeLowPct: 1, ChangeHighPct: 3, PriceLow: 420, PriceHigh: 440}, 400, []string{"不一致"}},
+		{"mismatch within tolerance", &Verdict{Direction: DirectionBullish, ChangeLowPct: 1, ChangeHighPct: 3, PriceLow: 408, PriceHigh: 416}, 400, nil},
+		{"beyond bands", &Verdict{Direction: DirectionBullish, PriceLow: 410, PriceHigh: 470}, 400, []string{"远超统计区间（第 3 日"}},
+		{"edge of bands", &Verdict{Direction: DirectionBullish, PriceLow: 345, PriceHigh: 455}, 400, nil},
+		{"bands ignored without price", &Verdict{Direction: DirectionBullish, PriceLow: 410, PriceHigh: 470}, 0, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verifyRange(&Snapshot{Bands: bands}, tt.v, tt.price, i18n.ZhCN)
+			if len(got) != len(tt.want) {
+				t.Fatalf("warnings = %q, want %d", got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				if !strings.Contains(got[i], w) {
+					t.Errorf("warning %d = %q, want it to contain %q", i, got[i], w)
+				}
+			}
+		})
+	}
+	if got := verifyRange(&Snapshot{}, &Verdict{ChangeLowPct: 2, ChangeHighPct: -2}, 0, i18n.En); len(got) != 1 || hasHan(got[0]) {
+		t.Errorf("en warnings = %q", got)
+	}
+}
+
+func TestCitations(t *testing.T) {
+	priceRe := regexp.MustCompile(`(现价)[^\d\n+-]{0,8}?` + numPattern)
+	pctRe := regexp.MustCompile(`(涨幅)[^\d\n+-]{0,6}?` + numPattern + `\s*%`)
+	for _, tt := range []struct {
+		name, text string
+		re         *regexp.Regexp
+		want       []string
+	}{
+		{"plain", "现价 400.5，现价 1,234.5", priceRe, []string{"400.5", "1,234.5"}},
+		{"signed", "现价 +12", priceRe, []string{"+12"}},
+		{"followed by percent", "现价回落 5%", priceRe, nil},
+		{"followed by times", "现价为净资产的 3 倍", priceRe, nil},
+		{"followed by x", "现价 12x 市盈率", priceRe, nil},
+		{"percent required by pattern", "涨幅 3.5%", pctRe, []string{"3.5"}},
+		{"forecast context", "预计现价 450", priceRe, nil},
+		{"forecast in previous sentence", "预计上涨。现价 400", priceRe, []string{"400"}},
+		{"no match", "走势平稳", priceRe, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range citations(tt.text, tt.re, 2) {
+				got = append(got, c.raw)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("%q: citations = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForecastContext(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want bool
+	}{
+		{"预计现价", true},
+		{"目标价现价", true},
+		{"預計現價", true},
+		{"今日现价", false},
+		{"预计明日上涨；现价", false}, // 分号分隔的另一分句
+		{"预计明日上涨。现价", false}, // 上一句
+		{"预计这只股票在经历较长时间的整理之后现价", false}, // 超出 12 个字符
+		{"We expect the current price", true},
+		{"It will likely hold; the current price", false},
+		{"Analysts expect gains. The current price", false},
+		{"Shares closed higher and the current price", false},
+	} {
+		pos := strings.LastIndex(tt.text, "现价")
+		if pos < 0 {
+			pos = strings.LastIndex(tt.text, "現價")
+		}
+		if pos < 0 {
+			pos = strings.LastIndex(tt.text, "current price")
+		}
+		if got := forecastContext(tt.text, pos); got != tt.want {
+			t.Errorf("forecastContext(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
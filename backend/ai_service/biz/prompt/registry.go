@@ -0,0 +1,345 @@
+// Package prompt 版本化的 prompt 模板（Go text/template），支持外部目录覆盖、热加载与按权重 A/B 选择。
+//
+// 模板目录包含 manifest.json 与模板文件：
+//
+//	{"templates": [
+//	  {"id": "prediction", "version": "v1", "file": "prediction_v1.tmpl", "weight": 80},
+//	  {"id": "prediction", "version": "v2", "file": "prediction_v2.tmpl", "weight": 20}
+//	]}
+//
+// 内置模板随二进制打包；AI_PROMPT_DIR（默认 ./prompts）下的同 id@version 会覆盖内置模板，新增的版本会参与选择。
+// weight 为 0 的版本只能按版本号显式选择。
+//
+// 带 "lang"（如 "en"、"zh-TW"）的条目是同一 id@version 的其他语言译本，不参与权重选择：
+// SelectLang 先按版本号或权重选出简体中文版本，再取该版本的对应译本，缺少译本时返回简体中文版本。
+package prompt
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+)
+
+//go:embed templates
+var builtin embed.FS
+
+const manifestName = "manifest.json"
+
+// Template 一个已解析的模板版本
+type Template struct {
+	ID      string
+	Version string
+	Lang    string // 译本语言，简体中文原版为空
+	Weight  int
+	Source  string // builtin 或外部文件路径
+	tmpl    *template.Template
+}
+
+// Key 模板标识 id@version，随预测结果记录
+func (t *Template) Key() string { return t.ID + "@" + t.Version }
+
+// Render 以 data 渲染模板
+func (t *Template) Render(data interface{}) (string, error) {
+	var b strings.Builder
+	if err := t.tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("渲染模板 %s: %w", t.Key(), err)
+	}
+	return b.String(), nil
+}
+
+type manifest struct {
+	Templates []struct {
+		ID      string `json:"id"`
+		Version string `json:"version"`
+		File    string `json:"file"`
+		Lang    string `json:"lang"`
+		Weight  int    `json:"weight"`
+	} `json:"templates"`
+}
+
+// Registry 模板注册表
+type Registry struct {
+	dir string
+
+	mu    sync.RWMutex
+	byID  map[string][]*Template // 同 id 下按版本号排序（见 versionLess）
+	stamp string                 // 外部目录文件指纹，变化时重载
+}
+
+// NewFromEnv 创建注册表：AI_PROMPT_DIR 指定外部模板目录（默认 prompts），
+// AI_PROMPT_RELOAD_SEC 指定热加载检查间隔（默认 10 秒，0 关闭）。
+func NewFromEnv() *Registry {
+	dir := strings.TrimSpace(os.Getenv("AI_PROMPT_DIR"))
+	if dir == "" {
+		dir = "prompts"
+	}
+	r := NewRegistry(dir)
+	interval := 10
+	if s := os.Getenv("AI_PROMPT_RELOAD_SEC"); s != "" {
+		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n >= 0 {
+			interval = n
+		}
+	}
+	if interval > 0 {
+		go r.watch(time.Duration(interval) * time.Second)
+	}
+	return r
+}
+
+// NewRegistry 加载内置模板与 dir 下的外部模板；dir 为空或不存在时仅使用内置模板。
+func NewRegistry(dir string) *Registry {
+	r := &Registry{dir: dir}
+	if err := r.Reload(); err != nil {
+		log.Printf("[prompt] load templates: %v", err)
+	}
+	return r
+}
+
+// Reload 重新加载全部模板；外部模板出错时保留上一次的结果（首次加载则仅用内置模板）并返回错误。
+func (r *Registry) Reload() error {
+	byID := map[string][]*Template{}
+	if err := loadManifest(builtin, "templates", "builtin", byID); err != nil {
+		return fmt.Errorf("内置模板: %w", err)
+	}
+	stamp := r.fingerprint()
+	var extErr error
+	if stamp != "" {
+		ext := make(map[string][]*Template, len(byID))
+		for id, list := range byID {
+			ext[id] = append([]*Template(nil), list...)
+		}
+		if err := loadManifest(os.DirFS(r.dir), ".", r.dir, ext); err != nil {
+			extErr = fmt.Errorf("外部模板 %s: %w", r.dir, err)
+		} else {
+			byID = ext
+		}
+	}
+	for _, list := range byID {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Version != list[j].Version {
+				return versionLess(list[i].Version, list[j].Version)
+			}
+			return list[i].Lang < list[j].Lang
+		})
+	}
+	r.mu.Lock()
+	if extErr == nil || r.byID == nil {
+		r.byID = byID
+	}
+	r.stamp = stamp // 同一份出错的文件不重复加载
+	r.mu.Unlock()
+	return extErr
+}
+
+// loadManifest 读取 root 下的 manifest.json 并解析其中的模板，同 id@version 覆盖已有项
+func loadManifest(fsys fs.FS, root, source string, byID map[string][]*Template) error {
+	bs, err := fs.ReadFile(fsys, filepath.ToSlash(filepath.Join(root, manifestName)))
+	if err != nil {
+		return err
+	}
+	var m manifest
+	if err := json.Unmarshal(bs, &m); err != nil {
+		return fmt.Errorf("解析 %s: %w", manifestName, err)
+	}
+	for _, e := range m.Templates {
+		if e.ID == "" || e.Version == "" || e.File == "" {
+			return fmt.Errorf("%s: id/version/file 不能为空", manifestName)
+		}
+		text, err := fs.ReadFile(fsys, filepath.ToSlash(filepath.Join(root, e.File)))
+		if err != nil {
+			return err
+		}
+		if e.Lang == i18n.ZhCN {
+			e.Lang = ""
+		}
+		tmpl, err := template.New(e.ID + "@" + e.Version + "/" + e.Lang).Option("missingkey=zero").Parse(string(text))
+		if err != nil {
+			return fmt.Errorf("解析模板 %s: %w", e.File, err)
+		}
+		src := source
+		if source != "builtin" {
+			src = filepath.Join(source, e.File)
+		}
+		t := &Template{ID: e.ID, Version: e.Version, Lang: e.Lang, Weight: e.Weight, Source: src, tmpl: tmpl}
+		list := byID[e.ID]
+		replaced := false
+		for i, old := range list {
+			if old.Version == e.Version && old.Lang == e.Lang {
+				list[i], replaced = t, true
+			}
+		}
+		if !replaced {
+			list = append(list, t)
+		}
+		byID[e.ID] = list
+	}
+	return nil
+}
+
+// fingerprint 外部目录下各文件的名称、大小与修改时间；目录不存在或无 manifest 时为空
+func (r *Registry) fingerprint() string {
+	if r.dir == "" {
+		return ""
+	}
+	if _, err := os.Stat(filepath.Join(r.dir, manifestName)); err != nil {
+		return ""
+	}
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s:%d:%d;", e.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	return b.String()
+}
+
+// watch 定期检查外部目录，文件变化时重载
+func (r *Registry) watch(interval time.Duration) {
+	for range time.Tick(interval) {
+		stamp := r.fingerprint()
+		r.mu.RLock()
+		changed := stamp != r.stamp
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		if err := r.Reload(); err != nil {
+			log.Printf("[prompt] reload failed, keep previous templates: %v", err)
+			continue
+		}
+		log.Printf("[prompt] templates reloaded from %s", r.dir)
+	}
+}
+
+// versionLess 版本号比较：数字部分按数值比较（v2 < v10），其余按字符
+func versionLess(a, b string) bool {
+	for a != "" && b != "" {
+		da, db := digitPrefix(a), digitPrefix(b)
+		switch {
+		case da != "" && db != "":
+			na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			if na != nb {
+				return na < nb
+			}
+			a, b = a[len(da):], b[len(db):]
+		case a[0] != b[0]:
+			return a[0] < b[0]
+		default:
+			a, b = a[1:], b[1:]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// digitPrefix s 开头的连续数字
+func digitPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// Select 选择简体中文模板：version 非空时按版本精确选择（也接受同 id 的 id@version 写法），否则在 weight>0 的版本间按权重随机；
+// 权重均为 0 时取最新版本。
+func (r *Registry) Select(id, version string) (*Template, error) {
+	if i := strings.Index(version, "@"); i >= 0 {
+		if version[:i] != id {
+			return nil, fmt.Errorf("模板 %s 不能用于 %s", version, id)
+		}
+		version = version[i+1:]
+	}
+	r.mu.RLock()
+	var list []*Template
+	for _, t := range r.byID[id] {
+		if t.Lang == "" {
+			list = append(list, t)
+		}
+	}
+	r.mu.RUnlock()
+	if len(list) == 0 {
+		return nil, fmt.Errorf("未找到模板 %s", id)
+	}
+	if version != "" {
+		for _, t := range list {
+			if t.Version == version {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("未找到模板 %s@%s", id, version)
+	}
+	total := 0
+	for _, t := range list {
+		total += t.Weight
+	}
+	if total <= 0 {
+		return list[len(list)-1], nil
+	}
+	n := rand.Intn(total)
+	for _, t := range list {
+		if n < t.Weight {
+			return t, nil
+		}
+		n -= t.Weight
+	}
+	return list[len(list)-1], nil
+}
+
+// SelectLang 同 Select 选出版本后取 lang 译本；lang 为空、简体中文或该版本无此译本时返回简体中文版本，
+// 调用方可比较返回模板的 Lang 判断是否需要另行要求输出语言。
+func (r *Registry) SelectLang(id, version, lang string) (*Template, error) {
+	t, err := r.Select(id, version)
+	if err != nil || lang == "" || lang == i18n.ZhCN {
+		return t, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.byID[t.ID] {
+		if v.Version == t.Version && v.Lang == lang {
+			return v, nil
+		}
+	}
+	return t, nil
+}
+
+// List 返回全部模板版本（按 id、version 排序）
+func (r *Registry) List() []*Template {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*Template
+	for _, list := range r.byID {
+		out = append(out, list...)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ID != out[j].ID {
+			return out[i].ID < out[j].ID
+		}
+		if out[i].Version != out[j].Version {
+			return versionLess(out[i].Version, out[j].Version)
+		}
+		return out[i].Lang < out[j].Lang
+	})
+	return out
+}
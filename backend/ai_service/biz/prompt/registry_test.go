@@ -0,0 +1,52 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVersionLess(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want bool
+	}{
+		{"v2", "v10", true},
+		{"v10", "v2", false},
+		{"v1", "v1", false},
+		{"v1", "v1.1", true},
+		{"v1.2", "v1.10", true},
+		{"v02", "v10", true},
+		{"v2a", "v2b", true},
+		{"a", "b", true},
+	} {
+		if got := versionLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("versionLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	dir := t.TempDir()
+	manifest := `{"templates": [
+		{"id": "t", "version": "v2", "file": "t.tmpl"},
+		{"id": "t", "version": "v10", "file": "t.tmpl"},
+		{"id": "other", "version": "v1", "file": "t.tmpl"}
+	]}`
+	for name, text := range map[string]string{manifestName: manifest, "t.tmpl": "x"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := NewRegistry(dir)
+	// 权重均为 0 时取最新版本
+	if tmpl, err := r.Select("t", ""); err != nil || tmpl.Version != "v10" {
+		t.Errorf("weighted fallback = %v, %v, want v10", tmpl, err)
+	}
+	if tmpl, err := r.Select("t", "t@v2"); err != nil || tmpl.Key() != "t@v2" {
+		t.Errorf("t@v2 = %v, %v", tmpl, err)
+	}
+	if tmpl, err := r.Select("t", "other@v1"); err == nil {
+		t.Errorf("other@v1 selected %s for id t", tmpl.Key())
+	}
+}
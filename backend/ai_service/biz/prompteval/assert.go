@@ -0,0 +1,194 @@
+package prompteval
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+)
+
+// 检查项
+const (
+	CheckVerdict   = "verdict"   // 回答末尾含可解析的结构化 JSON 结论
+	CheckDirection = "direction" // 结论给出合法方向（且在快照允许的方向内）
+	CheckRange     = "range"     // 价格区间（或涨跌幅区间）在现价 ±N% 内
+	CheckChinese   = "chinese"   // 分析正文以中文为主
+	CheckTickers   = "tickers"   // 未提及快照以外的股票代码
+	CheckNumbers   = "numbers"   // 数字核对无告警（引用的数字与快照一致）
+)
+
+// Checks 全部检查项（报告中的顺序）
+var Checks = []string{CheckVerdict, CheckDirection, CheckRange, CheckChinese, CheckTickers, CheckNumbers}
+
+// Check 一项检查的结果
+type Check struct {
+	Name   string `json:"name"`
+	Pass   bool   `json:"pass"`
+	Detail string `json:"detail,omitempty"`
+}
+
+var (
+	// tickerRe 港股代码：hk00700、00700.HK、（00700）
+	tickerRe = regexp.MustCompile(`(?i)\bhk(\d{4,5})\b|\b(\d{4,5})\.hk\b|[（(](0\d{4})[)）]`)
+	// fenceRe 代码块（结论 JSON 等），统计中文比例时去掉
+	fenceRe = regexp.MustCompile("(?s)```.*?```|\\{[^{}]*\"direction\"[^{}]*\\}")
+)
+
+// check 按配置与快照期望检查一次预测结果
+func check(c *Case, res *predictor.Result, cfg Config) []Check {
+	skip := map[string]bool{}
+	for _, name := range cfg.Skip {
+		skip[name] = true
+	}
+	var out []Check
+	for _, name := range Checks {
+		if skip[name] {
+			continue
+		}
+		var ok bool
+		var detail string
+		switch name {
+		case CheckVerdict:
+			ok, detail = checkVerdict(res)
+		case CheckDirection:
+			ok, detail = checkDirection(c, res)
+		case CheckRange:
+			ok, detail = checkRange(c, res, cfg)
+		case CheckChinese:
+			ok, detail = checkChinese(res, cfg.MinChineseRatio)
+		case CheckTickers:
+			ok, detail = checkTickers(c, res)
+		case CheckNumbers:
+			ok, detail = checkNumbers(res)
+		}
+		out = append(out, Check{Name: name, Pass: ok, Detail: detail})
+	}
+	return out
+}
+
+func checkVerdict(res *predictor.Result) (bool, string) {
+	if res.Verdict == nil {
+		return false, "回答末尾没有可解析的 JSON 结论"
+	}
+	return true, ""
+}
+
+func checkDirection(c *Case, res *predictor.Result) (bool, string) {
+	if res.Verdict == nil {
+		return false, "无结论"
+	}
+	d := res.Verdict.Direction
+	switch d {
+	case predictor.DirectionBullish, predictor.DirectionBearish, predictor.DirectionNeutral:
+	case "":
+		return false, "结论缺少 direction"
+	default:
+		return false, fmt.Sprintf("未知方向 %q", d)
+	}
+	if allow := c.Expect.Directions; len(allow) > 0 && !contains(allow, d) {
+		return false, fmt.Sprintf("方向 %s 不在期望的 %s 中", d, strings.Join(allow, "/"))
+	}
+	return true, d
+}
+
+// checkRange 价格区间上下沿均须在现价 ±N% 内；未给出价格区间时检查涨跌幅区间。
+func checkRange(c *Case, res *predictor.Result, cfg Config) (bool, string) {
+	v := res.Verdict
+	if v == nil {
+		return false, "无结论"
+	}
+	limit := cfg.MaxRangePct
+	if c.Expect.MaxRangePct > 0 {
+		limit = c.Expect.MaxRangePct
+	}
+	price := c.Quote.CurrentPrice
+	switch {
+	case v.PriceLow > 0 || v.PriceHigh > 0:
+		if v.PriceLow <= 0 || v.PriceHigh < v.PriceLow {
+			return false, fmt.Sprintf("价格区间 %.2f～%.2f 不完整或上下沿颠倒", v.PriceLow, v.PriceHigh)
+		}
+		lo, hi := (v.PriceLow/price-1)*100, (v.PriceHigh/price-1)*100
+		detail := fmt.Sprintf("%.2f～%.2f（现价 %.2f，%+.2f%%～%+.2f%%，上限 ±%.0f%%）", v.PriceLow, v.PriceHigh, price, lo, hi, limit)
+		return math.Abs(lo) <= limit && math.Abs(hi) <= limit, detail
+	case v.ChangeLowPct != 0 || v.ChangeHighPct != 0:
+		if v.ChangeHighPct < v.ChangeLowPct {
+			return false, fmt.Sprintf("涨跌幅区间 %+.2f%%～%+.2f%% 上下沿颠倒", v.ChangeLowPct, v.ChangeHighPct)
+		}
+		detail := fmt.Sprintf("涨跌幅 %+.2f%%～%+.2f%%（上限 ±%.0f%%）", v.ChangeLowPct, v.ChangeHighPct, limit)
+		return math.Abs(v.ChangeLowPct) <= limit && math.Abs(v.ChangeHighPct) <= limit, detail
+	}
+	return false, "结论未给出价格或涨跌幅区间"
+}
+
+// checkChinese 去掉代码块与结论 JSON 后，汉字在字母类字符（汉字 + 拉丁字母）中的占比
+func checkChinese(res *predictor.Result, min float64) (bool, string) {
+	var han, latin int
+	for _, r := range fenceRe.ReplaceAllString(res.Analysis, "") {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			han++
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			latin++
+		}
+	}
+	if han+latin == 0 {
+		return false, "分析正文为空"
+	}
+	ratio := float64(han) / float64(han+latin)
+	return ratio >= min, fmt.Sprintf("汉字占比 %.0f%%（下限 %.0f%%）", ratio*100, min*100)
+}
+
+// checkTickers 分析中出现的港股代码须为快照本身或 Expect.AllowTickers 中的代码
+func checkTickers(c *Case, res *predictor.Result) (bool, string) {
+	allow := map[string]bool{normalizeTicker(c.Code): true}
+	for _, t := range c.Expect.AllowTickers {
+		allow[normalizeTicker(t)] = true
+	}
+	seen := map[string]bool{}
+	var invented []string
+	for _, m := range tickerRe.FindAllStringSubmatch(res.Analysis, -1) {
+		t := normalizeTicker(m[1] + m[2] + m[3])
+		if allow[t] || seen[t] {
+			continue
+		}
+		seen[t] = true
+		invented = append(invented, t)
+	}
+	if len(invented) > 0 {
+		return false, "提及快照以外的代码：" + strings.Join(invented, "、")
+	}
+	return true, ""
+}
+
+// normalizeTicker 统一为 5 位数字，如 hk700、00700.HK → 00700
+func normalizeTicker(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "hk"), ".hk")
+	if len(s) < 5 {
+		s = strings.Repeat("0", 5-len(s)) + s
+	}
+	return s
+}
+
+func checkNumbers(res *predictor.Result) (bool, string) {
+	if len(res.Warnings) == 0 {
+		return true, ""
+	}
+	msgs := make([]string, 0, len(res.Warnings))
+	for _, w := range res.Warnings {
+		msgs = append(msgs, w.Message)
+	}
+	return false, strings.Join(msgs, "；")
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
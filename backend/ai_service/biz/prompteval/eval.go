@@ -0,0 +1,209 @@
+package prompteval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
+)
+
+// Config 评测参数
+type Config struct {
+	Suite           string   `json:"suite"`
+	Model           string   `json:"model,omitempty"`            // 为空时使用默认模型
+	TemplateVersion string   `json:"template_version,omitempty"` // 为空时按权重选择
+	Mode            string   `json:"mode,omitempty"`
+	MaxRangePct     float64  `json:"max_range_pct"`     // 价格区间上下沿偏离现价的上限（%）
+	MinChineseRatio float64  `json:"min_chinese_ratio"` // 分析正文汉字占比下限
+	Skip            []string `json:"skip,omitempty"`    // 跳过的检查项
+	Concurrency     int      `json:"concurrency"`
+}
+
+func (c *Config) normalize() error {
+	if c.MaxRangePct <= 0 {
+		c.MaxRangePct = 15
+	}
+	if c.MinChineseRatio <= 0 {
+		c.MinChineseRatio = 0.6
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = 1
+	}
+	for _, name := range c.Skip {
+		if !contains(Checks, name) {
+			return fmt.Errorf("未知检查项 %q（可选 %s）", name, strings.Join(Checks, "、"))
+		}
+	}
+	return nil
+}
+
+// CaseResult 一条快照的评测结果
+type CaseResult struct {
+	Name            string  `json:"name"`
+	Code            string  `json:"code"`
+	Model           string  `json:"model,omitempty"`
+	TemplateVersion string  `json:"template_version,omitempty"`
+	Pass            bool    `json:"pass"`
+	Error           string  `json:"error,omitempty"` // 预测失败原因
+	Checks          []Check `json:"checks,omitempty"`
+	Direction       string  `json:"direction,omitempty"`
+	Confidence      float64 `json:"confidence,omitempty"`
+	DurationMs      int64   `json:"duration_ms"`
+	Analysis        string  `json:"analysis,omitempty"`
+}
+
+// Report 评测报告
+type Report struct {
+	ID      string         `json:"id"`
+	Created time.Time      `json:"created"`
+	Config  Config         `json:"config"`
+	Passed  int            `json:"passed"`
+	Failed  int            `json:"failed"`
+	Checks  map[string]int `json:"check_failures"` // 各检查项失败次数
+	Cases   []*CaseResult  `json:"cases"`
+}
+
+// OK 全部快照通过
+func (r *Report) OK() bool { return r.Failed == 0 }
+
+// Run 按配置逐条预测并检查。progress 在每条结束后以 (已完成, 总数) 回调，可为 nil；单条预测失败计为不通过，不中止评测。
+func Run(ctx context.Context, p *predictor.Predictor, cases []*Case, cfg Config, progress func(done, total int)) (*Report, error) {
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	ctx = usage.WithEndpoint(ctx, "prompteval")
+	results := make([]*CaseResult, len(cases))
+	sem := make(chan struct{}, cfg.Concurrency)
+	var mu sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	for i, c := range cases {
+		wg.Add(1)
+		go func(i int, c *Case) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = &CaseResult{Name: c.Name, Code: c.Code, Error: ctx.Err().Error()}
+				return
+			}
+			defer func() { <-sem }()
+			results[i] = evaluate(ctx, p, c, cfg)
+			if progress != nil {
+				mu.Lock()
+				done++
+				progress(done, len(cases))
+				mu.Unlock()
+			}
+		}(i, c)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r := &Report{ID: storage.NewID(), Created: time.Now(), Config: cfg, Checks: map[string]int{}, Cases: results}
+	for _, cr := range results {
+		if cr.Pass {
+			r.Passed++
+		} else {
+			r.Failed++
+		}
+		for _, ch := range cr.Checks {
+			if !ch.Pass {
+				r.Checks[ch.Name]++
+			}
+		}
+	}
+	return r, nil
+}
+
+func evaluate(ctx context.Context, p *predictor.Predictor, c *Case, cfg Config) *CaseResult {
+	cr := &CaseResult{Name: c.Name, Code: c.Code}
+	start := time.Now()
+	res, err := p.Replay(ctx, predictor.Request{
+		Code: c.Code, Days: c.Days, Model: cfg.Model, TemplateVersion: cfg.TemplateVersion, Mode: cfg.Mode,
+	}, c.snapshot())
+	cr.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		cr.Error = err.Error()
+		return cr
+	}
+	cr.Model, cr.TemplateVersion, cr.Confidence, cr.Analysis = res.Model, res.TemplateVersion, res.Confidence, res.Analysis
+	if res.Verdict != nil {
+		cr.Direction = res.Verdict.Direction
+	}
+	cr.Checks = check(c, res, cfg)
+	cr.Pass = true
+	for _, ch := range cr.Checks {
+		cr.Pass = cr.Pass && ch.Pass
+	}
+	return cr
+}
+
+// Save 写入数据目录 prompteval/<id>.json，返回路径
+func (r *Report) Save() (string, error) {
+	path := storage.Path("prompteval", r.ID+".json")
+	if err := storage.WriteJSON(path, r); err != nil {
+		return "", fmt.Errorf("保存评测报告: %w", err)
+	}
+	return path, nil
+}
+
+// Markdown 报告摘要：总体通过率、各检查项失败次数与每条快照的检查结果
+func (r *Report) Markdown() string {
+	c := r.Config
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Prompt 评测报告 %s\n\n", r.ID)
+	model, version := c.Model, c.TemplateVersion
+	if model == "" {
+		model = "（默认模型）"
+	}
+	if version == "" {
+		version = "（按权重选择）"
+	}
+	fmt.Fprintf(&b, "- 评测集：%s，%d 条\n- 模型：%s，模板：%s\n", c.Suite, len(r.Cases), model, version)
+	fmt.Fprintf(&b, "- 价格区间上限 ±%.0f%%，汉字占比下限 %.0f%%\n", c.MaxRangePct, c.MinChineseRatio*100)
+	if len(c.Skip) > 0 {
+		fmt.Fprintf(&b, "- 跳过检查：%s\n", strings.Join(c.Skip, "、"))
+	}
+	fmt.Fprintf(&b, "- 结果：通过 %d，失败 %d\n", r.Passed, r.Failed)
+	var failures []string
+	for _, name := range Checks {
+		if n := r.Checks[name]; n > 0 {
+			failures = append(failures, fmt.Sprintf("%s %d", name, n))
+		}
+	}
+	if len(failures) > 0 {
+		fmt.Fprintf(&b, "- 检查项失败次数：%s\n", strings.Join(failures, "，"))
+	}
+	for _, cr := range r.Cases {
+		status := "PASS"
+		if !cr.Pass {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "\n## [%s] %s（%s）\n\n", status, cr.Name, cr.Code)
+		if cr.Error != "" {
+			fmt.Fprintf(&b, "- 预测失败：%s\n", cr.Error)
+			continue
+		}
+		fmt.Fprintf(&b, "- %s / %s，耗时 %.1fs\n", cr.Model, cr.TemplateVersion, float64(cr.DurationMs)/1000)
+		for _, ch := range cr.Checks {
+			mark := "✓"
+			if !ch.Pass {
+				mark = "✗"
+			}
+			if ch.Detail != "" {
+				fmt.Fprintf(&b, "- %s %s：%s\n", mark, ch.Name, ch.Detail)
+			} else {
+				fmt.Fprintf(&b, "- %s %s\n", mark, ch.Name)
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,130 @@
+// Package prompteval prompt 评测：将录制的数据快照（行情、大盘指数、日 K）按指定模板与模型跑完整预测流水线，
+// 逐条检查输出（结构化结论、方向、价格区间、中文、是否编造代码、数字核对），生成通过/失败报告。
+package prompteval
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+// Case 一条录制的数据快照及其期望
+type Case struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Code        string               `json:"code"`
+	Days        int32                `json:"days,omitempty"`    // 预测周期，为 0 时 3 个交易日
+	Trading     bool                 `json:"trading,omitempty"` // 录制时是否交易时段
+	Quote       *stock.StockInfo     `json:"quote"`
+	Indices     []*stock.MarketIndex `json:"indices,omitempty"`
+	Klines      []*stock.KLine       `json:"klines"` // 日 K（升序）
+	Expect      Expect               `json:"expect"`
+
+	path string
+}
+
+// Expect 单条快照的期望，未设置的项使用评测配置
+type Expect struct {
+	Directions   []string `json:"directions,omitempty"`    // 允许的方向，为空时不限
+	MaxRangePct  float64  `json:"max_range_pct,omitempty"` // 价格区间上下沿偏离现价的上限（%）
+	AllowTickers []string `json:"allow_tickers,omitempty"` // 允许提及的其他股票代码（如同业对比）
+}
+
+// snapshot 构造预测器使用的历史快照
+func (c *Case) snapshot() *predictor.Snapshot {
+	snap := predictor.NewSnapshot(c.Code, c.Days, c.Quote, c.Indices, c.Klines)
+	snap.IsTrading = c.Trading
+	return snap
+}
+
+func (c *Case) validate() error {
+	switch {
+	case c.Code == "":
+		return fmt.Errorf("%s: code 不能为空", c.path)
+	case c.Quote == nil || c.Quote.CurrentPrice <= 0:
+		return fmt.Errorf("%s: 缺少行情（quote.current_price）", c.path)
+	case len(c.Klines) == 0:
+		return fmt.Errorf("%s: 缺少日 K", c.path)
+	}
+	if c.Name == "" {
+		c.Name = strings.TrimSuffix(filepath.Base(c.path), ".json")
+	}
+	if c.Days <= 0 {
+		c.Days = 3
+	}
+	return nil
+}
+
+// LoadSuite 读取目录下全部 *.json 快照（按文件名排序）；only 非空时只保留名称在其中的快照。
+func LoadSuite(dir string, only []string) ([]*Case, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("读取评测集: %w", err)
+	}
+	sort.Strings(paths)
+	keep := map[string]bool{}
+	for _, name := range only {
+		keep[name] = true
+	}
+	var cases []*Case
+	for _, path := range paths {
+		c := &Case{path: path}
+		if err := storage.ReadJSON(path, c); err != nil {
+			return nil, fmt.Errorf("读取快照 %s: %w", path, err)
+		}
+		if err := c.validate(); err != nil {
+			return nil, err
+		}
+		if len(keep) > 0 && !keep[c.Name] {
+			continue
+		}
+		cases = append(cases, c)
+	}
+	if len(cases) == 0 {
+		return nil, fmt.Errorf("评测集 %s 中没有快照", dir)
+	}
+	return cases, nil
+}
+
+// Record 从股票服务录制 code 当前的行情、大盘指数与日 K，写入 dir/<name>.json 并返回路径。
+func Record(ctx context.Context, sc stockservice.Client, dir, name, code string, days int32) (string, error) {
+	rt, err := sc.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: code})
+	if err != nil {
+		return "", fmt.Errorf("获取 %s 行情失败: %w", code, err)
+	}
+	if rt == nil || rt.Stock == nil {
+		return "", fmt.Errorf("%s 无行情数据", code)
+	}
+	kl, err := sc.GetKline(ctx, &stock.GetKlineRequest{Code: code, Period: "day", Limit: 120})
+	if err != nil {
+		return "", fmt.Errorf("获取 %s 日 K 失败: %w", code, err)
+	}
+	if kl == nil || len(kl.Klines) == 0 {
+		return "", fmt.Errorf("%s 无日 K 数据", code)
+	}
+	c := &Case{
+		Name: name, Code: code, Days: days, Trading: predictor.IsHKTradingTime(),
+		Description: fmt.Sprintf("%s 录制于 %s", code, time.Now().Format("2006-01-02 15:04")),
+		Quote:       rt.Stock, Klines: kl.Klines,
+	}
+	if ms, err := sc.GetMarketSummary(ctx, &stock.GetMarketSummaryRequest{}); err == nil && ms != nil {
+		c.Indices = ms.Indices
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("创建评测集目录: %w", err)
+	}
+	path := filepath.Join(dir, name+".json")
+	if err := storage.WriteJSON(path, c); err != nil {
+		return "", fmt.Errorf("保存快照: %w", err)
+	}
+	return path, nil
+}
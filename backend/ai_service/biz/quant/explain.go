@@ -0,0 +1,60 @@
+package quant
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ModelName 规则模型在预测结果与记录中的模型名
+const ModelName = "quant"
+
+// Markdown 模板化的说明：结论、各信号明细与风险提示。name 为股票名称，可为空；lang 为规范化后的输出语言，
+// 信号明细与风险提示的语言由 Predict 的 lang 决定，两者应一致。
+func (f *Forecast) Markdown(code, name, lang string) string {
+	t := textFor(lang)
+	var b strings.Builder
+	if name != "" {
+		fmt.Fprintf(&b, t.headingNamed, name, code)
+	} else {
+		fmt.Fprintf(&b, t.heading, code)
+	}
+	fmt.Fprintf(&b, t.conclusion, f.Days, t.direction(f.Direction), f.Score, f.Confidence)
+	fmt.Fprintf(&b, t.basis, f.Price, f.ChangeLowPct, f.ChangeHighPct, f.PriceLow, f.PriceHigh, f.DailyVolPct)
+
+	b.WriteString(t.signalsHeading)
+	for _, s := range f.Signals {
+		if s.Weight == 0 {
+			fmt.Fprintf(&b, t.signalItem, s.Name, s.Detail)
+			continue
+		}
+		fmt.Fprintf(&b, t.weightedItem, s.Name, s.Weight*100, s.Score, t.leaning(s.Score), s.Detail)
+	}
+	if len(f.Risks) > 0 {
+		b.WriteString(t.risksHeading)
+		for _, r := range f.Risks {
+			fmt.Fprintf(&b, "- %s\n", r)
+		}
+	}
+	fmt.Fprintf(&b, t.footer, f.AsOf)
+	return b.String()
+}
+
+func (t *phrases) direction(dir string) string {
+	switch dir {
+	case DirectionBullish:
+		return t.bullish
+	case DirectionBearish:
+		return t.bearish
+	}
+	return t.neutral
+}
+
+func (t *phrases) leaning(score float64) string {
+	switch {
+	case score >= directionThreshold:
+		return t.leanBull
+	case score <= -directionThreshold:
+		return t.leanBear
+	}
+	return t.leanNone
+}
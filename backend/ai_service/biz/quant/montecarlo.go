@@ -0,0 +1,171 @@
+package quant
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 蒙特卡洛模拟的收益率模型
+const (
+	MCModelGBM       = "gbm"       // 几何布朗运动：按历史对数收益的均值与标准差生成正态收益
+	MCModelBootstrap = "bootstrap" // 从历史日收益中有放回抽样，保留肥尾与偏度
+)
+
+// MCConfig 模拟参数
+type MCConfig struct {
+	Model    string
+	Paths    int // 模拟路径数
+	Lookback int // 估计参数所用的历史收益天数
+}
+
+// MCConfigFromEnv AI_MC_MODEL 收益率模型 gbm（默认）或 bootstrap，AI_MC_PATHS 路径数（默认 2000，上限 20000），
+// AI_MC_LOOKBACK 历史收益天数（默认 60）。
+func MCConfigFromEnv() MCConfig {
+	cfg := MCConfig{Model: MCModelGBM, Paths: 2000, Lookback: 60}
+	if m := strings.ToLower(strings.TrimSpace(os.Getenv("AI_MC_MODEL"))); m == MCModelBootstrap {
+		cfg.Model = m
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("AI_MC_PATHS"))); err == nil && n >= 100 {
+		cfg.Paths = min(n, 20000)
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("AI_MC_LOOKBACK"))); err == nil && n >= 20 {
+		cfg.Lookback = n
+	}
+	return cfg
+}
+
+// BandPoint 第 Day 个交易日收盘价的分位数
+type BandPoint struct {
+	Day int     `json:"day"`
+	P5  float64 `json:"p5"`
+	P25 float64 `json:"p25"`
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P95 float64 `json:"p95"`
+}
+
+// Bands 蒙特卡洛价格分位带，Points 按天从 1 到 Days，供扇形图与 prompt 参考
+type Bands struct {
+	Model       string      `json:"model"`
+	Days        int         `json:"days"`
+	Paths       int         `json:"paths"`
+	Lookback    int         `json:"lookback"` // 实际使用的历史收益天数
+	Price       float64     `json:"price"`    // 起始价
+	DailyVolPct float64     `json:"daily_vol_pct"`
+	DriftPct    float64     `json:"drift_pct"` // 日均对数收益（%）
+	Points      []BandPoint `json:"points"`
+}
+
+// Simulate 由日 K（升序）估计收益分布，模拟 days 个交易日的价格路径并返回每日分位数。
+// price 为起始价，<=0 时取最新收盘价。随机种子由最新 K 线与参数决定，同一数据重复调用结果一致。
+// days 超过 MaxDays 时按 MaxDays 模拟（调用方应已校验）。
+func Simulate(bars []*stock.KLine, days int, price float64, cfg MCConfig) (*Bands, error) {
+	if days <= 0 {
+		days = 3
+	}
+	if days > MaxDays {
+		days = MaxDays
+	}
+	closes := make([]float64, 0, len(bars))
+	for _, b := range bars {
+		closes = append(closes, b.Close)
+	}
+	if len(closes) > cfg.Lookback+1 {
+		closes = closes[len(closes)-cfg.Lookback-1:]
+	}
+	rets := logReturns(closes)
+	if len(rets) < 20 {
+		return nil, fmt.Errorf("历史收益不足 20 个（当前 %d 个），无法模拟", len(rets))
+	}
+	if price <= 0 {
+		price = closes[len(closes)-1]
+	}
+	mu, sigma := mean(rets), stdev(rets)
+
+	last := bars[len(bars)-1]
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%.4f|%d|%s|%d|%d", last.Date, last.Close, days, cfg.Model, cfg.Paths, len(rets))
+	rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	// prices[d][i]：第 d+1 日第 i 条路径的价格
+	prices := make([][]float64, days)
+	for d := range prices {
+		prices[d] = make([]float64, cfg.Paths)
+	}
+	for i := 0; i < cfg.Paths; i++ {
+		logP := math.Log(price)
+		for d := 0; d < days; d++ {
+			if cfg.Model == MCModelBootstrap {
+				logP += rets[rng.Intn(len(rets))]
+			} else {
+				logP += mu - sigma*sigma/2 + sigma*rng.NormFloat64()
+			}
+			prices[d][i] = math.Exp(logP)
+		}
+	}
+
+	b := &Bands{
+		Model:       cfg.Model,
+		Days:        days,
+		Paths:       cfg.Paths,
+		Lookback:    len(rets),
+		Price:       price,
+		DailyVolPct: round(sigma*100, 2),
+		DriftPct:    round(mu*100, 3),
+		Points:      make([]BandPoint, 0, days),
+	}
+	for d, col := range prices {
+		sort.Float64s(col)
+		b.Points = append(b.Points, BandPoint{
+			Day: d + 1,
+			P5:  round(percentile(col, 0.05), 3),
+			P25: round(percentile(col, 0.25), 3),
+			P50: round(percentile(col, 0.50), 3),
+			P75: round(percentile(col, 0.75), 3),
+			P95: round(percentile(col, 0.95), 3),
+		})
+	}
+	return b, nil
+}
+
+// percentile 已排序样本的分位数（线性插值）
+func percentile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := min(lo+1, len(sorted)-1)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+// Text prompt 中 [统计区间] 块（按 lang 输出）：第 1 日与预测期末的分位数及相对起始价的涨跌幅
+func (b *Bands) Text(lang string) string {
+	t := textFor(lang)
+	modelName := t.bandsGBM
+	if b.Model == MCModelBootstrap {
+		modelName = t.bandsBootstrap
+	}
+	lines := []string{fmt.Sprintf(t.bandsIntro, b.Lookback, b.Paths, modelName, b.DailyVolPct, b.DriftPct, b.Price)}
+	for _, p := range b.Points {
+		if p.Day != 1 && p.Day != b.Days {
+			continue
+		}
+		label := fmt.Sprintf(t.bandsDay, p.Day)
+		if p.Day == b.Days {
+			label += t.bandsHorizon
+		}
+		lines = append(lines, fmt.Sprintf(t.bandsLine,
+			label, b.withPct(p.P5), b.withPct(p.P25), b.withPct(p.P50), b.withPct(p.P75), b.withPct(p.P95)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (b *Bands) withPct(v float64) string {
+	return fmt.Sprintf("%.3f(%+.1f%%)", v, (v/b.Price-1)*100)
+}
@@ -0,0 +1,343 @@
+// Package quant 基于历史日 K 的规则量化模型：趋势、动量、超买超卖、量能与相对恒指强弱五类信号加权评分，
+// 按近期波动率给出预计涨跌幅区间与置信度。结果确定、无需 LLM，未配置 API Key 时作为预测的兜底。
+package quant
+
+import (
+	"fmt"
+	"math"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/stock_service/biz/indicator"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// IndexSecID 相对强弱的基准指数（恒生指数）K 线代码
+const IndexSecID = "100.HSI"
+
+// MinBars 最少需要的日 K 数量
+const MinBars = 30
+
+// MaxDays 预测周期上限（交易日）；蒙特卡洛模拟按天 × 路径数分配内存
+const MaxDays = 60
+
+// 结论方向，与 predictor 的 Direction* 取值一致
+const (
+	DirectionBullish = "bullish"
+	DirectionBearish = "bearish"
+	DirectionNeutral = "neutral"
+)
+
+// directionThreshold 综合评分绝对值超过该值才判定方向，否则为震荡
+const directionThreshold = 0.15
+
+// Signal 单类信号：Score 在 [-1, 1]，正为看多；Weight 为 0 的信号仅作说明（如波动率）
+type Signal struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Score  float64 `json:"score"`
+	Detail string  `json:"detail"`
+}
+
+// Forecast 规则模型的预测结果
+type Forecast struct {
+	AsOf          string   `json:"as_of"`
+	Days          int      `json:"days"`
+	Price         float64  `json:"price"` // 预测基准价（现价，未取到时为最新收盘价）
+	Score         float64  `json:"score"` // 加权综合评分，[-1, 1]
+	Direction     string   `json:"direction"`
+	Confidence    float64  `json:"confidence"`
+	ChangeLowPct  float64  `json:"change_low_pct"`
+	ChangeHighPct float64  `json:"change_high_pct"`
+	PriceLow      float64  `json:"price_low"`
+	PriceHigh     float64  `json:"price_high"`
+	DailyVolPct   float64  `json:"daily_vol_pct"` // 近 20 日日收益率标准差（%）
+	Signals       []Signal `json:"signals"`
+	Risks         []string `json:"risks,omitempty"`
+}
+
+// Predict 由个股日 K（按日期升序）与恒指日 K（可为空，此时不计相对强弱）预测未来 days 个交易日。
+// price 为现价，<=0 时取最新收盘价；lang 为信号明细与风险提示的语言（i18n 规范化后的取值）。
+func Predict(bars, index []*stock.KLine, days int, price float64, lang string) (*Forecast, error) {
+	n := len(bars)
+	if n < MinBars {
+		return nil, i18n.Errorf(i18n.MsgQuantTooFewBars, MinBars, n)
+	}
+	t := textFor(lang)
+	if days <= 0 {
+		days = 3
+	}
+	closes := make([]float64, n)
+	vols := make([]float64, n)
+	for i, b := range bars {
+		closes[i], vols[i] = b.Close, float64(b.Volume)
+	}
+	last := closes[n-1]
+	if price <= 0 {
+		price = last
+	}
+	sigma := stdev(logReturns(closes[n-21:]))
+	if sigma <= 0 {
+		sigma = 0.01
+	}
+
+	f := &Forecast{AsOf: bars[n-1].Date, Days: days, Price: price, DailyVolPct: round(sigma*100, 2)}
+	f.Signals = append(f.Signals,
+		trendSignal(t, closes, sigma),
+		momentumSignal(t, closes, sigma),
+		rsiSignal(t, closes),
+		volumeSignal(t, closes, vols),
+	)
+	if s, ok := relativeStrength(t, bars, index, sigma); ok {
+		f.Signals = append(f.Signals, s)
+	}
+	f.Signals = append(f.Signals, Signal{
+		Name:   t.volatility,
+		Detail: fmt.Sprintf(t.volatilityDetail, sigma*100, sigma*math.Sqrt(252)*100),
+	})
+
+	var sum, wsum float64
+	for _, s := range f.Signals {
+		sum += s.Weight * s.Score
+		wsum += s.Weight
+	}
+	f.Score = round(sum/wsum, 2)
+	switch {
+	case f.Score >= directionThreshold:
+		f.Direction = DirectionBullish
+	case f.Score <= -directionThreshold:
+		f.Direction = DirectionBearish
+	default:
+		f.Direction = DirectionNeutral
+	}
+
+	// 区间：中枢按评分偏移 0.6 倍 σ√days，上下各 1 倍 σ√days
+	horizon := sigma * math.Sqrt(float64(days)) * 100
+	mid := f.Score * 0.6 * horizon
+	f.ChangeLowPct, f.ChangeHighPct = round(mid-horizon, 1), round(mid+horizon, 1)
+	f.PriceLow = round(price*(1+f.ChangeLowPct/100), 3)
+	f.PriceHigh = round(price*(1+f.ChangeHighPct/100), 3)
+
+	f.Confidence = confidence(f, n, sigma)
+	f.Risks = risks(t, f, sigma, n)
+	return f, nil
+}
+
+// trendSignal 均线排列与 MA20 斜率
+func trendSignal(t *phrases, closes []float64, sigma float64) Signal {
+	n := len(closes)
+	last := closes[n-1]
+	ma5, ma20 := mean(closes[n-5:]), mean(closes[n-20:])
+	ma20Prev := mean(closes[n-25 : n-5])
+	parts := []float64{sign(last - ma20), sign(ma5 - ma20)}
+	detail := fmt.Sprintf(t.trendDetail, last, ma20, t.aboveBelow(last-ma20), t.aboveBelow(ma5-ma20))
+	if n >= 60 {
+		ma60 := mean(closes[n-60:])
+		parts = append(parts, sign(ma20-ma60))
+		detail += fmt.Sprintf(t.trendMA60, ma60, t.aboveBelow(ma20-ma60))
+	}
+	slope := 0.0
+	if ma20Prev > 0 {
+		slope = ma20/ma20Prev - 1
+	}
+	parts = append(parts, math.Tanh(slope/(sigma*math.Sqrt(5))))
+	detail += fmt.Sprintf(t.trendSlope, slope*100)
+	return Signal{Name: t.trend, Weight: 0.30, Score: round(mean(parts), 2), Detail: detail}
+}
+
+// momentumSignal 20 日与 5 日收益（按波动率标准化）及 MACD 柱方向
+func momentumSignal(t *phrases, closes []float64, sigma float64) Signal {
+	n := len(closes)
+	ret20 := closes[n-1]/closes[n-21] - 1
+	ret5 := closes[n-1]/closes[n-6] - 1
+	macd := indicator.NewMACD(12, 26, 9)
+	var hist float64
+	for _, c := range closes {
+		hist = macd.Update(c).Hist
+	}
+	score := 0.5*math.Tanh(ret20/(sigma*math.Sqrt(20))/1.5) + 0.3*math.Tanh(ret5/(sigma*math.Sqrt(5))/1.5) + 0.2*sign(hist)
+	bar := t.redBar
+	if hist < 0 {
+		bar = t.greenBar
+	}
+	detail := fmt.Sprintf(t.momentumDetail, ret20*100, ret5*100, hist, bar)
+	return Signal{Name: t.momentum, Weight: 0.25, Score: round(score, 2), Detail: detail}
+}
+
+// rsiSignal RSI14 超买超卖（均值回归）：70 以上看空、30 以下看多，区间内不计分
+func rsiSignal(t *phrases, closes []float64) Signal {
+	r := indicator.NewRSI(14)
+	var rsi float64
+	for _, c := range closes {
+		rsi = r.Update(c)
+	}
+	score, state := 0.0, t.rsiNeutral
+	switch {
+	case rsi >= 70:
+		score, state = -math.Min(1, (rsi-70)/20), t.rsiOverbought
+	case rsi <= 30:
+		score, state = math.Min(1, (30-rsi)/20), t.rsiOversold
+	}
+	return Signal{Name: t.rsi, Weight: 0.15, Score: round(score, 2), Detail: fmt.Sprintf(t.rsiDetail, rsi, state)}
+}
+
+// volumeSignal 近 5 日均量相对前 20 日均量，与近 3 日价格方向是否配合
+func volumeSignal(t *phrases, closes, vols []float64) Signal {
+	n := len(closes)
+	base := mean(vols[n-25 : n-5])
+	if base <= 0 {
+		return Signal{Name: t.volume, Weight: 0.15, Detail: t.volumeMissing}
+	}
+	ratio := mean(vols[n-5:]) / base
+	move := closes[n-1]/closes[n-4] - 1
+	score := sign(move) * math.Tanh(ratio-1)
+	state := t.volumeSteady
+	switch {
+	case ratio >= 1.2 && move > 0:
+		state = t.volumeUp
+	case ratio >= 1.2 && move < 0:
+		state = t.volumeDown
+	case ratio <= 0.8 && move > 0:
+		state = t.volumeThinUp
+	case ratio <= 0.8 && move < 0:
+		state = t.volumeThinDown
+	}
+	return Signal{Name: t.volume, Weight: 0.15, Score: round(score, 2), Detail: fmt.Sprintf(t.volumeDetail, ratio, move*100, state)}
+}
+
+// relativeStrength 按日期对齐后，个股与恒指 20 日涨跌幅之差（按波动率标准化）
+func relativeStrength(t *phrases, bars, index []*stock.KLine, sigma float64) (Signal, bool) {
+	if len(index) == 0 {
+		return Signal{}, false
+	}
+	idx := make(map[string]float64, len(index))
+	for _, b := range index {
+		idx[b.Date] = b.Close
+	}
+	n := len(bars)
+	start, end := bars[n-21], bars[n-1]
+	i0, ok0 := idx[start.Date]
+	i1, ok1 := idx[end.Date]
+	if !ok0 || !ok1 || i0 <= 0 {
+		return Signal{}, false
+	}
+	stockRet := end.Close/start.Close - 1
+	indexRet := i1/i0 - 1
+	diff := stockRet - indexRet
+	state := t.stronger
+	if diff < 0 {
+		state = t.weaker
+	}
+	return Signal{
+		Name:   t.relative,
+		Weight: 0.15,
+		Score:  round(math.Tanh(diff/(sigma*math.Sqrt(20))), 2),
+		Detail: fmt.Sprintf(t.relativeDetail, stockRet*100, indexRet*100, state, math.Abs(diff)*100),
+	}, true
+}
+
+// confidence 评分强度与信号一致性越高越可信，波动大或样本少时下调；范围 [0.3, 0.8]
+func confidence(f *Forecast, bars int, sigma float64) float64 {
+	var agree, wsum float64
+	for _, s := range f.Signals {
+		if s.Weight == 0 {
+			continue
+		}
+		wsum += s.Weight
+		if f.Direction == DirectionNeutral {
+			if math.Abs(s.Score) < directionThreshold {
+				agree += s.Weight
+			}
+		} else if sign(s.Score) == sign(f.Score) && math.Abs(s.Score) >= 0.1 {
+			agree += s.Weight
+		}
+	}
+	if wsum > 0 {
+		agree /= wsum
+	}
+	c := 0.4 + 0.3*math.Abs(f.Score) + 0.15*agree
+	if f.Direction == DirectionNeutral {
+		c = 0.4 + 0.15*agree
+	}
+	annual := sigma * math.Sqrt(252)
+	switch {
+	case annual > 0.9:
+		c -= 0.1
+	case annual > 0.6:
+		c -= 0.05
+	}
+	if bars < 60 {
+		c -= 0.05
+	}
+	return round(math.Max(0.3, math.Min(0.8, c)), 2)
+}
+
+func risks(t *phrases, f *Forecast, sigma float64, bars int) []string {
+	var out []string
+	if annual := sigma * math.Sqrt(252); annual > 0.6 {
+		out = append(out, fmt.Sprintf(t.riskVolatile, annual*100))
+	}
+	for _, s := range f.Signals {
+		if s.Weight > 0 && f.Direction != DirectionNeutral && sign(s.Score) == -sign(f.Score) && math.Abs(s.Score) >= 0.3 {
+			out = append(out, fmt.Sprintf(t.riskOpposite, s.Name, s.Score, s.Detail))
+		}
+	}
+	if bars < 60 {
+		out = append(out, fmt.Sprintf(t.riskFewBars, bars))
+	}
+	return out
+}
+
+func logReturns(closes []float64) []float64 {
+	out := make([]float64, 0, len(closes))
+	for i := 1; i < len(closes); i++ {
+		if closes[i-1] > 0 && closes[i] > 0 {
+			out = append(out, math.Log(closes[i]/closes[i-1]))
+		}
+	}
+	return out
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	var s float64
+	for _, x := range xs {
+		s += x
+	}
+	return s / float64(len(xs))
+}
+
+func stdev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	m := mean(xs)
+	var s float64
+	for _, x := range xs {
+		s += (x - m) * (x - m)
+	}
+	return math.Sqrt(s / float64(len(xs)-1))
+}
+
+func sign(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+func (t *phrases) aboveBelow(x float64) string {
+	if x >= 0 {
+		return t.above
+	}
+	return t.below
+}
+
+func round(x float64, digits int) float64 {
+	p := math.Pow(10, float64(digits))
+	return math.Round(x*p) / p
+}
@@ -0,0 +1,224 @@
+package quant
+
+import "hk_stock_assistant/backend/ai_service/biz/i18n"
+
+// phrases 规则模型说明文字（信号明细、风险提示与 Markdown）的一种语言版本；
+// 英文格式串用 %[n] 调整参数顺序，各语言的参数含义须一致。
+type phrases struct {
+	// Markdown
+	heading, headingNamed        string
+	conclusion, basis            string
+	signalsHeading, risksHeading string
+	signalItem, weightedItem     string
+	footer                       string
+	bullish, bearish, neutral    string // 结论方向
+	leanBull, leanBear, leanNone string // 单项信号倾向
+
+	// 信号名称
+	trend, momentum, rsi, volume, relative, volatility string
+
+	// 信号明细
+	above, below                                       string
+	trendDetail, trendMA60, trendSlope                 string
+	momentumDetail, redBar, greenBar                   string
+	rsiDetail, rsiNeutral, rsiOverbought, rsiOversold  string
+	volumeDetail, volumeMissing, volumeSteady          string
+	volumeUp, volumeDown, volumeThinUp, volumeThinDown string
+	relativeDetail, stronger, weaker                   string
+	volatilityDetail                                   string
+
+	// 风险提示
+	riskVolatile, riskOpposite, riskFewBars string
+
+	// 统计区间（Bands.Text）
+	bandsIntro, bandsGBM, bandsBootstrap string
+	bandsDay, bandsHorizon, bandsLine    string
+}
+
+var phrasebook = map[string]*phrases{
+	i18n.ZhCN: {
+		heading:        "## %s 量化信号分析\n\n",
+		headingNamed:   "## %s（%s）量化信号分析\n\n",
+		conclusion:     "**结论**：未来 %d 个交易日%s，综合评分 %+.2f（-1 至 +1），置信度 %.2f。\n\n",
+		basis:          "以 %.3f 为基准，预计涨跌幅 %+.1f%% 至 %+.1f%%，价格区间 %.3f 至 %.3f（按近 20 日日波动率 %.2f%% 估算的 1 倍标准差区间）。\n\n",
+		signalsHeading: "### 信号明细\n\n",
+		risksHeading:   "\n### 风险提示\n\n",
+		signalItem:     "- **%s**：%s\n",
+		weightedItem:   "- **%s**（权重 %.0f%%，得分 %+.2f，%s）：%s\n",
+		footer:         "\n> 本分析由规则量化模型基于截至 %s 的日 K 计算，未使用大模型，不含新闻与基本面，仅供参考，不构成投资建议。\n",
+		bullish:        "偏多",
+		bearish:        "偏空",
+		neutral:        "以震荡为主",
+		leanBull:       "偏多",
+		leanBear:       "偏空",
+		leanNone:       "中性",
+
+		trend:      "趋势",
+		momentum:   "动量",
+		rsi:        "超买超卖",
+		volume:     "量能",
+		relative:   "相对强弱",
+		volatility: "波动率",
+
+		above:            "上方",
+		below:            "下方",
+		trendDetail:      "收盘 %.3f 位于 MA20 %.3f %s，MA5 位于 MA20 %s",
+		trendMA60:        "，MA20 位于 MA60 %.3f %s",
+		trendSlope:       "；MA20 较 5 日前 %+.2f%%",
+		momentumDetail:   "20 日涨跌 %+.2f%%，5 日涨跌 %+.2f%%，MACD 柱 %.3f（%s）",
+		redBar:           "红柱",
+		greenBar:         "绿柱",
+		rsiDetail:        "RSI14 %.1f，%s",
+		rsiNeutral:       "处于中性区间",
+		rsiOverbought:    "超买，短线有回调压力",
+		rsiOversold:      "超卖，短线有反弹可能",
+		volumeDetail:     "近 5 日均量为前 20 日的 %.2f 倍，近 3 日涨跌 %+.2f%%，%s",
+		volumeMissing:    "成交量数据不足",
+		volumeSteady:     "量能平稳",
+		volumeUp:         "放量上涨，量价配合",
+		volumeDown:       "放量下跌，抛压较重",
+		volumeThinUp:     "缩量上涨，上攻动能不足",
+		volumeThinDown:   "缩量下跌，抛压减轻",
+		relativeDetail:   "20 日涨跌 %+.2f%%，同期恒指 %+.2f%%，%s %.2f 个百分点",
+		stronger:         "强于大盘",
+		weaker:           "弱于大盘",
+		volatilityDetail: "近 20 日日波动率 %.2f%%（年化约 %.0f%%），用于估算区间宽度",
+
+		riskVolatile: "年化波动率约 %.0f%%，价格区间仅为 1 倍标准差估计，实际波动可能超出",
+		riskOpposite: "%s信号与结论相反（%+.2f）：%s",
+		riskFewBars:  "仅有 %d 根日 K，长期均线不可用",
+
+		bandsIntro:     "基于近 %d 个交易日收益率的 %d 次蒙特卡洛模拟（%s，日波动率 %.2f%%，日均收益 %+.3f%%），起始价 %.3f：",
+		bandsGBM:       "几何布朗运动",
+		bandsBootstrap: "历史收益自助抽样",
+		bandsDay:       "第 %d 个交易日",
+		bandsHorizon:   "（预测期末）",
+		bandsLine:      "%s: 5%%分位=%s, 25%%分位=%s, 中位数=%s, 75%%分位=%s, 95%%分位=%s",
+	},
+	i18n.ZhTW: {
+		heading:        "## %s 量化訊號分析\n\n",
+		headingNamed:   "## %s（%s）量化訊號分析\n\n",
+		conclusion:     "**結論**：未來 %d 個交易日%s，綜合評分 %+.2f（-1 至 +1），信心度 %.2f。\n\n",
+		basis:          "以 %.3f 為基準，預計漲跌幅 %+.1f%% 至 %+.1f%%，價格區間 %.3f 至 %.3f（按近 20 日日波動率 %.2f%% 估算的 1 倍標準差區間）。\n\n",
+		signalsHeading: "### 訊號明細\n\n",
+		risksHeading:   "\n### 風險提示\n\n",
+		signalItem:     "- **%s**：%s\n",
+		weightedItem:   "- **%s**（權重 %.0f%%，得分 %+.2f，%s）：%s\n",
+		footer:         "\n> 本分析由規則量化模型基於截至 %s 的日 K 計算，未使用大模型，不含新聞與基本面，僅供參考，不構成投資建議。\n",
+		bullish:        "偏多",
+		bearish:        "偏空",
+		neutral:        "以震盪為主",
+		leanBull:       "偏多",
+		leanBear:       "偏空",
+		leanNone:       "中性",
+
+		trend:      "趨勢",
+		momentum:   "動能",
+		rsi:        "超買超賣",
+		volume:     "量能",
+		relative:   "相對強弱",
+		volatility: "波動率",
+
+		above:            "上方",
+		below:            "下方",
+		trendDetail:      "收市 %.3f 位於 MA20 %.3f %s，MA5 位於 MA20 %s",
+		trendMA60:        "，MA20 位於 MA60 %.3f %s",
+		trendSlope:       "；MA20 較 5 日前 %+.2f%%",
+		momentumDetail:   "20 日漲跌 %+.2f%%，5 日漲跌 %+.2f%%，MACD 柱 %.3f（%s）",
+		redBar:           "紅柱",
+		greenBar:         "綠柱",
+		rsiDetail:        "RSI14 %.1f，%s",
+		rsiNeutral:       "處於中性區間",
+		rsiOverbought:    "超買，短線有回調壓力",
+		rsiOversold:      "超賣，短線有反彈可能",
+		volumeDetail:     "近 5 日均量為前 20 日的 %.2f 倍，近 3 日漲跌 %+.2f%%，%s",
+		volumeMissing:    "成交量資料不足",
+		volumeSteady:     "量能平穩",
+		volumeUp:         "放量上漲，量價配合",
+		volumeDown:       "放量下跌，賣壓較重",
+		volumeThinUp:     "縮量上漲，上攻動能不足",
+		volumeThinDown:   "縮量下跌，賣壓減輕",
+		relativeDetail:   "20 日漲跌 %+.2f%%，同期恒指 %+.2f%%，%s %.2f 個百分點",
+		stronger:         "強於大市",
+		weaker:           "弱於大市",
+		volatilityDetail: "近 20 日日波動率 %.2f%%（年化約 %.0f%%），用於估算區間寬度",
+
+		riskVolatile: "年化波動率約 %.0f%%，價格區間僅為 1 倍標準差估計，實際波動可能超出",
+		riskOpposite: "%s訊號與結論相反（%+.2f）：%s",
+		riskFewBars:  "僅有 %d 根日 K，長期均線不可用",
+
+		bandsIntro:     "基於近 %d 個交易日收益率的 %d 次蒙地卡羅模擬（%s，日波動率 %.2f%%，日均收益 %+.3f%%），起始價 %.3f：",
+		bandsGBM:       "幾何布朗運動",
+		bandsBootstrap: "歷史收益自助抽樣",
+		bandsDay:       "第 %d 個交易日",
+		bandsHorizon:   "（預測期末）",
+		bandsLine:      "%s: 5%%分位=%s, 25%%分位=%s, 中位數=%s, 75%%分位=%s, 95%%分位=%s",
+	},
+	i18n.En: {
+		heading:        "## %s quant signal analysis\n\n",
+		headingNamed:   "## %s (%s) quant signal analysis\n\n",
+		conclusion:     "**Conclusion**: over the next %d trading days the outlook is %s, with a composite score of %+.2f (-1 to +1) and confidence %.2f.\n\n",
+		basis:          "From a base price of %.3f, the expected change is %+.1f%% to %+.1f%%, a price range of %.3f to %.3f (a one-standard-deviation band based on the 20-day daily volatility of %.2f%%).\n\n",
+		signalsHeading: "### Signals\n\n",
+		risksHeading:   "\n### Risks\n\n",
+		signalItem:     "- **%s**: %s\n",
+		weightedItem:   "- **%s** (weight %.0f%%, score %+.2f, %s): %s\n",
+		footer:         "\n> Computed by the rule-based quant model from daily K-lines up to %s. No LLM, news or fundamentals were used. For reference only, not investment advice.\n",
+		bullish:        "bullish",
+		bearish:        "bearish",
+		neutral:        "mostly range-bound",
+		leanBull:       "bullish",
+		leanBear:       "bearish",
+		leanNone:       "neutral",
+
+		trend:      "Trend",
+		momentum:   "Momentum",
+		rsi:        "Overbought/oversold",
+		volume:     "Volume",
+		relative:   "Relative strength",
+		volatility: "Volatility",
+
+		above:            "above",
+		below:            "below",
+		trendDetail:      "close %.3[1]f is %[3]s MA20 %.3[2]f, MA5 is %[4]s MA20",
+		trendMA60:        ", MA20 is %[2]s MA60 %.3[1]f",
+		trendSlope:       "; MA20 %+.2f%% vs 5 days ago",
+		momentumDetail:   "20-day change %+.2f%%, 5-day change %+.2f%%, MACD histogram %.3f (%s)",
+		redBar:           "positive",
+		greenBar:         "negative",
+		rsiDetail:        "RSI14 %.1f, %s",
+		rsiNeutral:       "in the neutral zone",
+		rsiOverbought:    "overbought, short-term pullback risk",
+		rsiOversold:      "oversold, a short-term rebound is possible",
+		volumeDetail:     "5-day average volume is %.2fx the prior 20 days, 3-day change %+.2f%%, %s",
+		volumeMissing:    "not enough volume data",
+		volumeSteady:     "steady volume",
+		volumeUp:         "rising on higher volume, price and volume agree",
+		volumeDown:       "falling on higher volume, heavy selling",
+		volumeThinUp:     "rising on lower volume, weak buying momentum",
+		volumeThinDown:   "falling on lower volume, selling is easing",
+		relativeDetail:   "20-day change %+.2f%% vs %+.2f%% for the Hang Seng Index, %s by %.2f percentage points",
+		stronger:         "outperforming the market",
+		weaker:           "underperforming the market",
+		volatilityDetail: "20-day daily volatility %.2f%% (about %.0f%% annualised), used to size the range",
+
+		riskVolatile: "annualised volatility is about %.0f%%; the price range is only a one-standard-deviation estimate and actual moves may exceed it",
+		riskOpposite: "%s signal contradicts the conclusion (%+.2f): %s",
+		riskFewBars:  "only %d daily bars, long-term moving averages are unavailable",
+
+		bandsIntro:     "Monte Carlo simulation over the last %d trading days of returns, %d paths (%s, daily volatility %.2f%%, mean daily return %+.3f%%), starting price %.3f:",
+		bandsGBM:       "geometric Brownian motion",
+		bandsBootstrap: "bootstrapped historical returns",
+		bandsDay:       "trading day %d",
+		bandsHorizon:   " (end of horizon)",
+		bandsLine:      "%s: P5=%s, P25=%s, median=%s, P75=%s, P95=%s",
+	},
+}
+
+// textFor 指定语言的说明文字，未知语言用简体中文
+func textFor(lang string) *phrases {
+	if t, ok := phrasebook[lang]; ok {
+		return t
+	}
+	return phrasebook[i18n.ZhCN]
+}
@@ -0,0 +1,127 @@
+// Package storage ai_service 本地持久化：数据目录与 JSON / JSONL 文件读写。
+package storage
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DataDir 本地数据目录，AI_DATA_DIR 覆盖，默认当前工作目录下的 data。
+func DataDir() string {
+	dir := strings.TrimSpace(os.Getenv("AI_DATA_DIR"))
+	if dir == "" {
+		dir = "data"
+	}
+	return dir
+}
+
+// Path 返回数据目录下的路径并确保父目录存在
+func Path(elem ...string) string {
+	p := filepath.Join(append([]string{DataDir()}, elem...)...)
+	_ = os.MkdirAll(filepath.Dir(p), 0o755)
+	return p
+}
+
+// Dir 返回数据目录下的子目录并确保其存在
+func Dir(elem ...string) string {
+	p := filepath.Join(append([]string{DataDir()}, elem...)...)
+	_ = os.MkdirAll(p, 0o755)
+	return p
+}
+
+// fileLocks 同一文件的追加/重写串行化
+var fileLocks sync.Map
+
+func lockFor(path string) *sync.Mutex {
+	mu, _ := fileLocks.LoadOrStore(path, &sync.Mutex{})
+	return mu.(*sync.Mutex)
+}
+
+// AppendJSONL 追加一行 JSON
+func AppendJSONL(path string, v interface{}) error {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	mu := lockFor(path)
+	mu.Lock()
+	defer mu.Unlock()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(bs, '\n'))
+	return err
+}
+
+// ReadJSONL 逐行解码，newItem 返回每行要解码进的对象，fn 处理解码结果；文件不存在视为空。损坏的行被跳过。
+func ReadJSONL(path string, newItem func() interface{}, fn func(interface{})) error {
+	mu := lockFor(path)
+	mu.Lock()
+	defer mu.Unlock()
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		item := newItem()
+		if err := json.Unmarshal(line, item); err != nil {
+			continue
+		}
+		fn(item)
+	}
+	return sc.Err()
+}
+
+// WriteJSON 原子写入（先写临时文件再重命名）
+func WriteJSON(path string, v interface{}) error {
+	bs, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	mu := lockFor(path)
+	mu.Lock()
+	defer mu.Unlock()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bs, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// ReadJSON 读取 JSON 文件；文件不存在时返回 os.ErrNotExist 类错误
+func ReadJSON(path string, v interface{}) error {
+	mu := lockFor(path)
+	mu.Lock()
+	defer mu.Unlock()
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// NewID 生成时间有序的短 ID，如 20240601T103000-1a2b3c4d
+func NewID() string {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return time.Now().Format("20060102T150405") + "-" + hex.EncodeToString(b[:])
+}
@@ -0,0 +1,308 @@
+// Package tools 供 LLM 在分析中按需调用的数据工具（OpenAI function calling），数据均来自 stock_service。
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	stock "hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+// maxResultLen 单次工具结果回传给模型的最大字节数，超出截断
+const maxResultLen = 6000
+
+// handler 执行工具，返回会被序列化为 JSON 的结果
+type handler func(ctx context.Context, args json.RawMessage) (interface{}, error)
+
+type tool struct {
+	def llm.Tool
+	run handler
+}
+
+// Set 工具集合
+type Set struct {
+	stockClient stockservice.Client
+	tools       []tool
+}
+
+// New 创建工具集合：get_quote、get_kline、get_fundamentals、get_index、get_capital_flow、search_news。
+func New(stockClient stockservice.Client) *Set {
+	s := &Set{stockClient: stockClient}
+	s.tools = []tool{
+		{def("get_quote", "获取港股个股实时行情（现价、涨跌幅、成交量）", params(codeParam)), s.getQuote},
+		{def("get_kline", "获取历史 K 线（前复权），可指定周期与条数", params(codeParam,
+			prop{"period", "string", "周期：day/week/month/60m/30m/15m/5m/1m，默认 day", false},
+			prop{"limit", "integer", "返回条数，默认 30，最大 120", false})), s.getKline},
+		{def("get_fundamentals", "获取港股估值与基本面：市盈率 TTM、市净率、市值、每股收益/净资产、ROE、换手率、52 周高低", params(codeParam)), s.getFundamentals},
+		{def("get_index", "获取大盘指数（恒生指数、恒生科技指数）最新点位，days>0 时附带近 days 日收盘", params(
+			prop{"symbol", "string", "HSI（恒生指数）或 HSTECH（恒生科技），默认 HSI", false},
+			prop{"days", "integer", "附带的日线天数，默认 0，最大 60", false})), s.getIndex},
+		{def("get_capital_flow", "获取个股近 N 日资金流向（主力/超大单/大单/中单/小单净流入，港元）", params(codeParam,
+			prop{"days", "integer", "天数，默认 10，最大 30", false})), s.getCapitalFlow},
+		{def("search_news", "按公司名或代码搜索最新资讯", params(
+			prop{"query", "string", "关键字，公司名或港股代码（代码会自动转为公司名）", true},
+			prop{"limit", "integer", "条数，默认 5，最大 10", false})), s.searchNews},
+	}
+	return s
+}
+
+// Definitions 传给模型的 tools 声明
+func (s *Set) Definitions() []llm.Tool {
+	out := make([]llm.Tool, 0, len(s.tools))
+	for _, t := range s.tools {
+		out = append(out, t.def)
+	}
+	return out
+}
+
+// Names 工具名列表
+func (s *Set) Names() []string {
+	out := make([]string, 0, len(s.tools))
+	for _, t := range s.tools {
+		out = append(out, t.def.Function.Name)
+	}
+	return out
+}
+
+// Call 执行工具，返回 JSON 文本结果（超长截断）
+func (s *Set) Call(ctx context.Context, name, arguments string) (string, error) {
+	for _, t := range s.tools {
+		if t.def.Function.Name != name {
+			continue
+		}
+		args := json.RawMessage(strings.TrimSpace(arguments))
+		if len(args) == 0 {
+			args = json.RawMessage("{}")
+		}
+		v, err := t.run(ctx, args)
+		if err != nil {
+			return "", err
+		}
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return truncate(bs, maxResultLen), nil
+	}
+	return "", fmt.Errorf("未知工具: %s", name)
+}
+
+// truncate 超过 max 字节时在不超过 max 的最后一个 UTF-8 字符边界截断并加标记
+func truncate(bs []byte, max int) string {
+	if len(bs) <= max {
+		return string(bs)
+	}
+	n := max
+	for n > 0 && !utf8.RuneStart(bs[n]) {
+		n--
+	}
+	return string(bs[:n]) + "...(truncated)"
+}
+
+// prop 参数声明
+type prop struct {
+	name, typ, desc string
+	required        bool
+}
+
+var codeParam = prop{"code", "string", "港股代码，如 hk00700 或 00700", true}
+
+func def(name, desc string, parameters map[string]interface{}) llm.Tool {
+	return llm.Tool{Type: "function", Function: llm.FunctionDef{Name: name, Description: desc, Parameters: parameters}}
+}
+
+func params(props ...prop) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+	for _, p := range props {
+		properties[p.name] = map[string]string{"type": p.typ, "description": p.desc}
+		if p.required {
+			required = append(required, p.name)
+		}
+	}
+	return map[string]interface{}{"type": "object", "properties": properties, "required": required}
+}
+
+// toolArgs 各工具参数的并集
+type toolArgs struct {
+	Code   string `json:"code"`
+	Period string `json:"period"`
+	Limit  int    `json:"limit"`
+	Days   int    `json:"days"`
+	Symbol string `json:"symbol"`
+	Query  string `json:"query"`
+}
+
+func parseArgs(raw json.RawMessage) (*toolArgs, error) {
+	var a toolArgs
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return nil, fmt.Errorf("参数不是合法 JSON: %w", err)
+	}
+	a.Code = strings.TrimSpace(a.Code)
+	return &a, nil
+}
+
+func requireCode(raw json.RawMessage) (*toolArgs, error) {
+	a, err := parseArgs(raw)
+	if err != nil {
+		return nil, err
+	}
+	if a.Code == "" {
+		return nil, fmt.Errorf("缺少参数 code")
+	}
+	return a, nil
+}
+
+// clamp v<=0 时取 def，超过 max 时取 max
+func clamp(v, def, max int) int {
+	if v <= 0 {
+		return def
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func (s *Set) getQuote(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := requireCode(raw)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.stockClient.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: a.Code})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Stock == nil {
+		return nil, fmt.Errorf("无行情数据")
+	}
+	return resp.Stock, nil
+}
+
+// klineRows K 线压缩为 [日期, 开, 收, 高, 低, 成交量] 行，节省 token
+func klineRows(klines []*stock.KLine) [][]interface{} {
+	rows := make([][]interface{}, 0, len(klines))
+	for _, k := range klines {
+		if k != nil {
+			rows = append(rows, []interface{}{k.Date, k.Open, k.Close, k.High, k.Low, k.Volume})
+		}
+	}
+	return rows
+}
+
+func (s *Set) getKline(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := requireCode(raw)
+	if err != nil {
+		return nil, err
+	}
+	if a.Period == "" {
+		a.Period = "day"
+	}
+	resp, err := s.stockClient.GetKline(ctx, &stock.GetKlineRequest{Code: a.Code, Period: a.Period, Limit: int32(clamp(a.Limit, 30, 120))})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"code":    a.Code,
+		"period":  a.Period,
+		"columns": []string{"date", "open", "close", "high", "low", "volume"},
+		"bars":    klineRows(resp.GetKlines()),
+	}, nil
+}
+
+func (s *Set) getFundamentals(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := requireCode(raw)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.stockClient.GetFundamentals(ctx, &stock.GetFundamentalsRequest{Code: a.Code})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Fundamentals == nil {
+		return nil, fmt.Errorf("无基本面数据")
+	}
+	return resp.Fundamentals, nil
+}
+
+// indexSecIDs 指数代码 -> 东方财富 secid（GetKline 支持直接传 secid）
+var indexSecIDs = map[string]string{"HSI": "100.HSI", "HSTECH": "124.HSTECH"}
+
+func (s *Set) getIndex(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := parseArgs(raw)
+	if err != nil {
+		return nil, err
+	}
+	symbol := strings.ToUpper(strings.TrimSpace(a.Symbol))
+	if symbol == "" {
+		symbol = "HSI"
+	}
+	secID, ok := indexSecIDs[symbol]
+	if !ok {
+		return nil, fmt.Errorf("不支持的指数: %s（可选 HSI、HSTECH）", a.Symbol)
+	}
+	resp, err := s.stockClient.GetMarketSummary(ctx, &stock.GetMarketSummaryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{"indices": resp.GetIndices()}
+	if a.Days > 0 {
+		kr, err := s.stockClient.GetKline(ctx, &stock.GetKlineRequest{Code: secID, Period: "day", Limit: int32(clamp(a.Days, 0, 60))})
+		if err != nil {
+			return nil, err
+		}
+		out["symbol"] = symbol
+		out["columns"] = []string{"date", "open", "close", "high", "low", "volume"}
+		out["bars"] = klineRows(kr.GetKlines())
+	}
+	return out, nil
+}
+
+func (s *Set) getCapitalFlow(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := requireCode(raw)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.stockClient.GetCapitalFlow(ctx, &stock.GetCapitalFlowRequest{Code: a.Code, Days: int32(clamp(a.Days, 10, 30))})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"code": a.Code, "flows": resp.GetFlows()}, nil
+}
+
+// hkCodeRe 形如 700、00700、hk00700 的港股代码
+var hkCodeRe = regexp.MustCompile(`^(?i:hk)?\d{1,5}$`)
+
+func (s *Set) searchNews(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	a, err := parseArgs(raw)
+	if err != nil {
+		return nil, err
+	}
+	query := strings.TrimSpace(a.Query)
+	if query == "" {
+		return nil, fmt.Errorf("缺少参数 query")
+	}
+	// 资讯按代码检索效果差，先换成公司名
+	if hkCodeRe.MatchString(query) {
+		if resp, err := s.stockClient.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: query}); err == nil && resp.GetStock() != nil && resp.Stock.Name != "" {
+			query = resp.Stock.Name
+		}
+	}
+	resp, err := s.stockClient.SearchNews(ctx, &stock.SearchNewsRequest{Keyword: query, Limit: int32(clamp(a.Limit, 5, 10))})
+	if err != nil {
+		return nil, err
+	}
+	items := resp.GetItems()
+	for _, it := range items {
+		if r := []rune(it.Summary); len(r) > 150 {
+			it.Summary = string(r[:150]) + "..."
+		}
+	}
+	return map[string]interface{}{"query": query, "items": items}, nil
+}
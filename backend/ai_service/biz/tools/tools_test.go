@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"abc", 3, "abc"},
+		{"abcd", 3, "abc...(truncated)"},
+		{"腾讯控股", 12, "腾讯控股"},
+		{"腾讯控股", 7, "腾讯...(truncated)"}, // 第 3 个字占 6～8 字节，回退到其起点
+		{"腾讯控股", 6, "腾讯...(truncated)"},
+		{"a腾", 2, "a...(truncated)"},
+		{"腾", 2, "...(truncated)"},
+	} {
+		got := truncate([]byte(tt.in), tt.max)
+		if got != tt.want || !utf8.ValidString(got) {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+		}
+		if body := strings.TrimSuffix(got, "...(truncated)"); len(body) > tt.max {
+			t.Errorf("truncate(%q, %d) kept %d bytes", tt.in, tt.max, len(body))
+		}
+	}
+}
@@ -0,0 +1,295 @@
+// Package usage LLM token 用量与费用：按价目表计价，逐次写入数据目录 usage/<日期>.jsonl，
+// 按日、模型、入口汇总，并执行可选的每日预算（超出后拒绝或降级到备用模型）。
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/llm"
+	"hk_stock_assistant/backend/ai_service/biz/metrics"
+	"hk_stock_assistant/backend/ai_service/biz/storage"
+)
+
+// 预算超出后的处理方式
+const (
+	ActionReject    = "reject"    // 拒绝新的 LLM 请求
+	ActionDowngrade = "downgrade" // 改用 AI_BUDGET_FALLBACK_MODEL
+)
+
+// MaxDays 汇总查询最多回看的天数
+const MaxDays = 90
+
+// Price 每百万 token 的价格，单位见 LLM_PRICE_CURRENCY
+type Price struct {
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"` // 推理 token 计入 completion，按同价计费
+}
+
+// Record 一次 LLM 调用的用量
+type Record struct {
+	Time      time.Time `json:"time"`
+	Model     string    `json:"model"`
+	Endpoint  string    `json:"endpoint"`
+	llm.Usage           // 服务商未返回时为估算值
+	Cost      float64   `json:"cost"`
+	Estimated bool      `json:"estimated,omitempty"`
+}
+
+// Totals 一组调用的合计；Key 为模型名或入口名，日合计为空
+type Totals struct {
+	Key              string  `json:"key,omitempty"`
+	Calls            int64   `json:"calls"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+	ReasoningTokens  int64   `json:"reasoning_tokens"`
+	TotalTokens      int64   `json:"total_tokens"`
+	Cost             float64 `json:"cost"`
+	EstimatedCalls   int64   `json:"estimated_calls"` // 用量为估算的调用数
+	Unpriced         bool    `json:"unpriced,omitempty"`
+}
+
+func (t *Totals) add(r *Record, priced bool) {
+	t.Calls++
+	t.PromptTokens += r.PromptTokens
+	t.CompletionTokens += r.CompletionTokens
+	t.ReasoningTokens += r.ReasoningTokens
+	t.TotalTokens += r.TotalTokens
+	t.Cost += r.Cost
+	if r.Estimated {
+		t.EstimatedCalls++
+	}
+	if !priced {
+		t.Unpriced = true
+	}
+}
+
+// Day 一天的用量：合计及按模型、入口拆分（按费用从高到低）
+type Day struct {
+	Date       string    `json:"date"`
+	Total      Totals    `json:"total"`
+	ByModel    []*Totals `json:"by_model"`
+	ByEndpoint []*Totals `json:"by_endpoint"`
+}
+
+// Budget 每日预算配置与当日状态
+type Budget struct {
+	Daily         float64 `json:"daily"` // 0 表示不限
+	Action        string  `json:"action"`
+	FallbackModel string  `json:"fallback_model,omitempty"`
+	TodayCost     float64 `json:"today_cost"`
+	Exceeded      bool    `json:"exceeded"`
+	Currency      string  `json:"currency"`
+}
+
+// Tracker 用量记录与预算判断
+type Tracker struct {
+	prices        map[string]Price
+	currency      string
+	daily         float64
+	action        string
+	fallbackModel string
+
+	mu        sync.Mutex
+	today     string
+	todayCost float64
+}
+
+func init() {
+	metrics.Describe("llm_tokens_total", "LLM token 用量，按模型与类型 prompt/completion 统计")
+	metrics.Describe("llm_cost_total", "LLM 费用（价目表单位），按模型统计")
+	metrics.Describe("llm_budget_rejections_total", "超出每日预算被拒绝或降级的请求数，按处理方式统计")
+}
+
+// NewFromEnv LLM_PRICES 价目表（JSON，模型名 → {"prompt":x,"completion":y}，每百万 token），
+// LLM_PRICE_CURRENCY 币种（默认 CNY）；AI_DAILY_BUDGET 每日预算（默认 0 不限），
+// AI_BUDGET_ACTION 超出后 reject（默认）或 downgrade，降级目标为 AI_BUDGET_FALLBACK_MODEL（未设置时仍拒绝）。
+func NewFromEnv() *Tracker {
+	t := &Tracker{
+		prices:        map[string]Price{},
+		currency:      strings.TrimSpace(os.Getenv("LLM_PRICE_CURRENCY")),
+		action:        strings.TrimSpace(os.Getenv("AI_BUDGET_ACTION")),
+		fallbackModel: strings.TrimSpace(os.Getenv("AI_BUDGET_FALLBACK_MODEL")),
+	}
+	if t.currency == "" {
+		t.currency = "CNY"
+	}
+	if t.action != ActionDowngrade {
+		t.action = ActionReject
+	}
+	if s := strings.TrimSpace(os.Getenv("LLM_PRICES")); s != "" {
+		if err := json.Unmarshal([]byte(s), &t.prices); err != nil {
+			log.Printf("[usage] LLM_PRICES 解析失败，按 0 计费: %v", err)
+		}
+	}
+	if s := strings.TrimSpace(os.Getenv("AI_DAILY_BUDGET")); s != "" {
+		if v, err := strconv.ParseFloat(s, 64); err == nil && v > 0 {
+			t.daily = v
+		}
+	}
+	t.today = dateOf(time.Now())
+	for _, r := range readDay(t.today) {
+		t.todayCost += r.Cost
+	}
+	return t
+}
+
+func dateOf(t time.Time) string { return t.Format("2006-01-02") }
+
+func dayPath(date string) string { return storage.Path("usage", date+".jsonl") }
+
+// price 模型价格；未配置时为 0，ok 为 false
+func (t *Tracker) price(model string) (Price, bool) {
+	p, ok := t.prices[model]
+	return p, ok
+}
+
+// Record 记录一次调用；endpoint 取自 ctx（见 WithEndpoint），estimated 表示用量为估算
+func (t *Tracker) Record(ctx context.Context, model string, u llm.Usage, estimated bool) {
+	p, _ := t.price(model)
+	rec := &Record{
+		Time:      time.Now(),
+		Model:     model,
+		Endpoint:  EndpointFrom(ctx),
+		Usage:     u,
+		Cost:      (float64(u.PromptTokens)*p.Prompt + float64(u.CompletionTokens)*p.Completion) / 1e6,
+		Estimated: estimated,
+	}
+	date := dateOf(rec.Time)
+	t.mu.Lock()
+	if date != t.today {
+		t.today, t.todayCost = date, 0
+	}
+	t.todayCost += rec.Cost
+	t.mu.Unlock()
+
+	metrics.Add("llm_tokens_total", float64(u.PromptTokens), "model", model, "type", "prompt")
+	metrics.Add("llm_tokens_total", float64(u.CompletionTokens), "model", model, "type", "completion")
+	metrics.Add("llm_cost_total", rec.Cost, "model", model)
+	if err := storage.AppendJSONL(dayPath(date), rec); err != nil {
+		log.Printf("[usage] 写入用量记录失败: %v", err)
+	}
+}
+
+// Admit 调用 LLM 前检查当日预算：未超出时原样返回 model；超出时按 AI_BUDGET_ACTION 返回降级模型或错误。
+func (t *Tracker) Admit(model string) (string, error) {
+	b := t.Budget()
+	if !b.Exceeded {
+		return model, nil
+	}
+	if t.action == ActionDowngrade && t.fallbackModel != "" {
+		if model != t.fallbackModel {
+			metrics.Inc("llm_budget_rejections_total", "action", ActionDowngrade)
+			log.Printf("[usage] 今日费用 %.4f 已达预算 %.4f，模型 %s 降级为 %s", b.TodayCost, b.Daily, model, t.fallbackModel)
+		}
+		return t.fallbackModel, nil
+	}
+	metrics.Inc("llm_budget_rejections_total", "action", ActionReject)
+	return "", i18n.Errorf(i18n.MsgBudgetExceeded, b.TodayCost, b.Currency, b.Daily)
+}
+
+// Budget 预算配置与当日已用费用
+func (t *Tracker) Budget() Budget {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if d := dateOf(time.Now()); d != t.today {
+		t.today, t.todayCost = d, 0
+	}
+	return Budget{
+		Daily:         t.daily,
+		Action:        t.action,
+		FallbackModel: t.fallbackModel,
+		TodayCost:     round(t.todayCost),
+		Exceeded:      t.daily > 0 && t.todayCost >= t.daily,
+		Currency:      t.currency,
+	}
+}
+
+// Summary 最近 days 天（含今天，最多 MaxDays）的每日汇总，按日期倒序；无调用的日期也列出。
+func (t *Tracker) Summary(days int) []*Day {
+	if days <= 0 {
+		days = 7
+	}
+	if days > MaxDays {
+		days = MaxDays
+	}
+	now := time.Now()
+	out := make([]*Day, 0, days)
+	for i := 0; i < days; i++ {
+		date := dateOf(now.AddDate(0, 0, -i))
+		out = append(out, t.summarize(date, readDay(date)))
+	}
+	return out
+}
+
+func (t *Tracker) summarize(date string, recs []*Record) *Day {
+	d := &Day{Date: date, ByModel: []*Totals{}, ByEndpoint: []*Totals{}}
+	models := map[string]*Totals{}
+	endpoints := map[string]*Totals{}
+	group := func(m map[string]*Totals, list *[]*Totals, key string) *Totals {
+		g, ok := m[key]
+		if !ok {
+			g = &Totals{Key: key}
+			m[key] = g
+			*list = append(*list, g)
+		}
+		return g
+	}
+	for _, r := range recs {
+		_, priced := t.price(r.Model)
+		d.Total.add(r, priced)
+		group(models, &d.ByModel, r.Model).add(r, priced)
+		group(endpoints, &d.ByEndpoint, r.Endpoint).add(r, priced)
+	}
+	d.Total.Cost = round(d.Total.Cost)
+	for _, list := range [][]*Totals{d.ByModel, d.ByEndpoint} {
+		for _, g := range list {
+			g.Cost = round(g.Cost)
+		}
+		sort.SliceStable(list, func(a, b int) bool {
+			if list[a].Cost != list[b].Cost {
+				return list[a].Cost > list[b].Cost
+			}
+			return list[a].TotalTokens > list[b].TotalTokens
+		})
+	}
+	return d
+}
+
+func readDay(date string) []*Record {
+	var out []*Record
+	err := storage.ReadJSONL(dayPath(date), func() interface{} { return &Record{} }, func(v interface{}) {
+		out = append(out, v.(*Record))
+	})
+	if err != nil {
+		log.Printf("[usage] 读取 %s 用量失败: %v", date, err)
+	}
+	return out
+}
+
+// round 保留 6 位小数，避免累加误差出现在输出中
+func round(v float64) float64 { return math.Round(v*1e6) / 1e6 }
+
+type endpointKey struct{}
+
+// WithEndpoint 标记 ctx 内 LLM 调用所属的入口（如 predict、predict_stream、chat），用于按入口汇总
+func WithEndpoint(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, endpointKey{}, endpoint)
+}
+
+// EndpointFrom ctx 所属入口，未标记时为 other
+func EndpointFrom(ctx context.Context) string {
+	if s, ok := ctx.Value(endpointKey{}).(string); ok && s != "" {
+		return s
+	}
+	return "other"
+}
@@ -0,0 +1,79 @@
+// Command backtest 预测器滚动回测：按历史日 K 还原过去交易日的数据快照并预测，以其后的实际收盘评估。
+//
+//	go run ./cmd/backtest -codes hk00700,hk09988 -from 2024-01-02 -to 2024-06-28 -days 3 -step 5 -models quant,glm-4-flash
+//
+// 需要股票服务（-stock，默认 127.0.0.1:8888）提供历史日 K；LLM 配置与 ai_service 相同（读取当前目录 .env），
+// 可将 LLM_BASE_URL 指向本地 OpenAI 兼容服务以免费回测。报告 JSON 写入数据目录 backtest/<id>.json，摘要输出到标准输出。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/cloudwego/kitex/client"
+	"hk_stock_assistant/backend/ai_service/biz/backtest"
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+func main() {
+	var (
+		cfg       backtest.Config
+		codes     = flag.String("codes", "", "股票代码，逗号分隔（必填）")
+		models    = flag.String("models", "", "模型，逗号分隔；quant 为规则量化模型，为空时使用默认模型")
+		templates = flag.String("templates", "", "LLM 预测的模板版本，逗号分隔（如 v2,v3），为空时按权重选择")
+		days      = flag.Int("days", 3, "预测周期（交易日）")
+		stockAddr = flag.String("stock", "127.0.0.1:8888", "股票服务地址")
+	)
+	flag.StringVar(&cfg.From, "from", "", "首个预测日 YYYY-MM-DD（必填）")
+	flag.StringVar(&cfg.To, "to", "", "最后一个预测日 YYYY-MM-DD（必填）")
+	flag.IntVar(&cfg.Step, "step", 5, "每隔多少个交易日预测一次")
+	flag.StringVar(&cfg.Mode, "mode", "", "预测模式 single 或 debate")
+	flag.Float64Var(&cfg.NeutralPct, "neutral", 1, "实际涨跌幅绝对值不超过此百分比视为震荡")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 2, "同时进行的预测数")
+	flag.BoolVar(&cfg.Refresh, "refresh", false, "重新拉取已保存的历史日 K")
+	flag.Parse()
+	cfg.Codes, cfg.Models, cfg.TemplateVersions = split(*codes), split(*models), split(*templates)
+	cfg.Days = int32(*days)
+
+	stockClient, err := stockservice.NewClient("stock_service", client.WithHostPorts(*stockAddr))
+	if err != nil {
+		log.Fatalf("init stock client: %v", err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := backtest.Run(ctx, predictor.New(stockClient), stockClient, cfg, func(done, total int) {
+		fmt.Fprintf(os.Stderr, "\r回测进度 %d/%d", done, total)
+		if done == total {
+			fmt.Fprintln(os.Stderr)
+		}
+	})
+	if err != nil {
+		log.Fatalf("回测失败: %v", err)
+	}
+	path, err := report.Save()
+	if err != nil {
+		log.Printf("%v", err)
+	}
+	fmt.Print(report.Markdown())
+	if path != "" {
+		fmt.Printf("\n完整报告：%s\n", path)
+	}
+}
+
+// split 逗号分隔的列表，去掉空白项
+func split(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+// Command llmmock 本地模拟的 OpenAI 兼容 LLM 服务，用于无外网时端到端运行 ai_service、流式服务与网关。
+//
+//	go run ./cmd/llmmock -addr :8899 -script cmd/llmmock/script.example.json
+//
+// 然后以 LLM_API_KEY=mock LLM_BASE_URL=http://127.0.0.1:8899/v1 启动 ai_service（不要设置 ZHIPU_API_KEY）。
+// 不指定脚本时回显请求并附带结构化结论；脚本格式见 biz/llm/llmmock 的 Reply。
+// GET /_requests 查看收到的请求，POST /_reset 清空请求记录与脚本使用次数。
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"hk_stock_assistant/backend/ai_service/biz/llm/llmmock"
+)
+
+func main() {
+	var (
+		addr   = flag.String("addr", ":8899", "监听地址")
+		script = flag.String("script", "", "脚本文件（Reply 的 JSON 数组），为空时总是回显")
+		chunk  = flag.Int("chunk", 8, "流式每个分片的字符数")
+		delay  = flag.Duration("delay", 30*time.Millisecond, "流式分片间隔")
+	)
+	flag.Parse()
+
+	var replies []llmmock.Reply
+	if *script != "" {
+		var err error
+		if replies, err = llmmock.LoadScript(*script); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+	mock := llmmock.New(replies...)
+	mock.ChunkSize, mock.ChunkDelay = *chunk, *delay
+
+	mux := http.NewServeMux()
+	mux.Handle("/", mock)
+	mux.HandleFunc("/_requests", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, mock.Requests())
+	})
+	mux.HandleFunc("/_reset", func(w http.ResponseWriter, r *http.Request) {
+		mock.Reset()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	log.Printf("[llmmock] listening on %s, %d scripted replies", *addr, len(replies))
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatalf("[llmmock] %v", err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,120 @@
+// Command prompteval prompt 评测：将录制的数据快照按指定模板与模型预测，检查结构化结论、方向、价格区间、
+// 中文、是否编造代码与数字核对，输出通过/失败报告，有失败时退出码为 1。
+//
+//	go run ./cmd/prompteval -mock                                  # 内置模拟 LLM，供 CI 使用
+//	go run ./cmd/prompteval -model glm-4-flash -template v3        # 真实 LLM（配置同 ai_service，读取当前目录 .env）
+//	go run ./cmd/prompteval -record hk00700,hk09988 -suite my-suite # 从股票服务录制当前快照
+//
+// 快照为 JSON 文件（Case：行情、大盘指数、日 K 与期望），默认评测集 cmd/prompteval/snapshots 为合成数据的示例。
+// 报告 JSON 写入数据目录 prompteval/<id>.json，摘要输出到标准输出。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/cloudwego/kitex/client"
+	"hk_stock_assistant/backend/ai_service/biz/llm/llmmock"
+	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/prompteval"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
+)
+
+func main() {
+	var (
+		cfg        prompteval.Config
+		only       = flag.String("only", "", "只评测这些快照（名称，逗号分隔）")
+		skip       = flag.String("skip", "", "跳过的检查项，逗号分隔（"+strings.Join(prompteval.Checks, ",")+"）")
+		mock       = flag.Bool("mock", false, "使用进程内的模拟 LLM（忽略 .env 中的 LLM 配置）")
+		mockScript = flag.String("mock-script", "", "模拟 LLM 的脚本文件，为空时回显请求并附带结构化结论")
+		record     = flag.String("record", "", "从股票服务录制这些代码的当前快照到评测集目录后退出，逗号分隔")
+		days       = flag.Int("days", 3, "录制快照的预测周期（交易日）")
+		stockAddr  = flag.String("stock", "127.0.0.1:8888", "股票服务地址（仅录制时使用）")
+	)
+	flag.StringVar(&cfg.Suite, "suite", "cmd/prompteval/snapshots", "评测集目录")
+	flag.StringVar(&cfg.Model, "model", "", "模型，quant 为规则量化模型，为空时使用默认模型")
+	flag.StringVar(&cfg.TemplateVersion, "template", "", "预测模板版本（如 v3），为空时按权重选择")
+	flag.StringVar(&cfg.Mode, "mode", "", "预测模式 single 或 debate")
+	flag.Float64Var(&cfg.MaxRangePct, "max-range", 15, "价格区间上下沿偏离现价的上限（%）")
+	flag.Float64Var(&cfg.MinChineseRatio, "min-chinese", 0.6, "分析正文汉字占比下限")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 1, "同时进行的预测数")
+	flag.Parse()
+	cfg.Skip = split(*skip)
+
+	stockClient, err := stockservice.NewClient("stock_service", client.WithHostPorts(*stockAddr))
+	if err != nil {
+		log.Fatalf("init stock client: %v", err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if codes := split(*record); len(codes) > 0 {
+		for _, code := range codes {
+			path, err := prompteval.Record(ctx, stockClient, cfg.Suite, code, code, int32(*days))
+			if err != nil {
+				log.Fatalf("录制失败: %v", err)
+			}
+			fmt.Printf("已录制 %s\n", path)
+		}
+		return
+	}
+
+	cases, err := prompteval.LoadSuite(cfg.Suite, split(*only))
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if *mock {
+		var replies []llmmock.Reply
+		if *mockScript != "" {
+			if replies, err = llmmock.LoadScript(*mockScript); err != nil {
+				log.Fatalf("%v", err)
+			}
+		}
+		srv := httptest.NewServer(llmmock.New(replies...))
+		defer srv.Close()
+		// 须在 predictor.New 之前设置：LLM 客户端在创建时读取环境变量
+		os.Unsetenv("ZHIPU_API_KEY")
+		os.Unsetenv("LLM_PROVIDERS")
+		os.Setenv("LLM_API_KEY", "mock")
+		os.Setenv("LLM_BASE_URL", srv.URL+"/v1")
+		os.Setenv("LLM_MODEL", "mock")
+	}
+
+	report, err := prompteval.Run(ctx, predictor.New(stockClient), cases, cfg, func(done, total int) {
+		fmt.Fprintf(os.Stderr, "\r评测进度 %d/%d", done, total)
+		if done == total {
+			fmt.Fprintln(os.Stderr)
+		}
+	})
+	if err != nil {
+		log.Fatalf("评测失败: %v", err)
+	}
+	path, err := report.Save()
+	if err != nil {
+		log.Printf("%v", err)
+	}
+	fmt.Print(report.Markdown())
+	if path != "" {
+		fmt.Printf("\n完整报告：%s\n", path)
+	}
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
+
+// split 逗号分隔的列表，去掉空白项
+func split(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -2,10 +2,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	ai "hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/ai_service/biz/calibration"
+	"hk_stock_assistant/backend/ai_service/biz/chat"
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/jobs"
 	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/quant"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
 	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
 )
 
@@ -13,24 +21,462 @@ import (
 type AIServiceImpl struct {
 	stockClient stockservice.Client
 	predictor   *predictor.Predictor
+	jobs        *jobs.Manager
 }
 
-func NewAIServiceImpl(stockClient stockservice.Client, p *predictor.Predictor) *AIServiceImpl {
-	return &AIServiceImpl{stockClient: stockClient, predictor: p}
+func NewAIServiceImpl(stockClient stockservice.Client, p *predictor.Predictor, jm *jobs.Manager) *AIServiceImpl {
+	return &AIServiceImpl{stockClient: stockClient, predictor: p, jobs: jm}
 }
 
 func (s *AIServiceImpl) GetPrediction(ctx context.Context, req *ai.GetPredictionRequest) (*ai.GetPredictionResponse, error) {
 	log.Printf("GetPrediction: code=%s", req.Code)
-	analysis, confidence, newsSummary, err := s.predictor.Predict(ctx, req.Code, req.Days, req.Model)
+	res, err := s.predictor.Predict(usage.WithEndpoint(ctx, "rpc/GetPrediction"), toPredictorRequest(req))
+	if err != nil {
+		return nil, i18n.LocalizeError(err, req.Language)
+	}
+	return &ai.GetPredictionResponse{Result_: toPredictionResult(res)}, nil
+}
+
+func toPredictorRequest(req *ai.GetPredictionRequest) predictor.Request {
+	return predictor.Request{
+		Code: req.Code, Days: req.Days, Model: req.Model, TemplateVersion: req.TemplateVersion, Mode: req.Mode,
+		ForceRefresh: req.ForceRefresh, Models: req.Models, Language: req.Language,
+	}
+}
+
+func toPredictionResult(res *predictor.Result) *ai.PredictionResult_ {
+	return &ai.PredictionResult_{
+		Code:            res.Code,
+		Confidence:      res.Confidence,
+		Analysis:        res.Analysis,
+		NewsSummary_:    res.NewsSummary,
+		Technical:       toTechnicalIndicators(res.Technical),
+		Verdict:         toVerdict(res.Verdict),
+		Model:           res.Model,
+		TemplateVersion: res.TemplateVersion,
+		PredictionId:    res.ID,
+		ToolCalls:       toToolInvocations(res.ToolCalls),
+		Mode:            res.Mode,
+		Debate:          toDebate(res.Debate),
+		Cached:          res.Cached,
+		Bands:           toPriceBands(res.Bands),
+		RawConfidence:   res.RawConfidence,
+		Calibrated:      res.Calibrated,
+		Ensemble:        toEnsemble(res.Ensemble),
+		Warnings:        toVerificationWarnings(res.Warnings),
+		Language:        res.Language,
+		Context:         toDataSnapshot(res.Context),
+	}
+}
+
+func toDataSnapshot(d *predictor.DataSnapshot) *ai.DataSnapshot {
+	if d == nil {
+		return nil
+	}
+	out := &ai.DataSnapshot{
+		Code:        d.Code,
+		Time:        formatTime(d.Time),
+		IsTrading:   d.IsTrading,
+		Indices:     make([]*ai.SnapshotIndex, 0, len(d.Indices)),
+		Bars:        int32(d.Bars),
+		LastBarDate: d.LastBarDate,
+		Sources:     make([]*ai.DataSource, 0, len(d.Sources)),
+	}
+	if q := d.Quote; q != nil {
+		out.Quote = &ai.SnapshotQuote{Code: q.Code, Name: q.Name, CurrentPrice: q.CurrentPrice, ChangePercent: q.ChangePercent, Volume: q.Volume, Timestamp: q.Timestamp}
+	}
+	for _, idx := range d.Indices {
+		if idx != nil {
+			out.Indices = append(out.Indices, &ai.SnapshotIndex{Name: idx.Name, Value: idx.Value, Change: idx.Change, ChangePercent: idx.ChangePercent})
+		}
+	}
+	for _, src := range d.Sources {
+		out.Sources = append(out.Sources, &ai.DataSource{Name: src.Name, Ok: src.OK, Critical: src.Critical, Error: src.Error, FetchedAt: formatTime(src.FetchedAt), ElapsedMs: src.ElapsedMs})
+	}
+	return out
+}
+
+func toVerificationWarnings(ws []predictor.Warning) []*ai.VerificationWarning {
+	if len(ws) == 0 {
+		return nil
+	}
+	out := make([]*ai.VerificationWarning, 0, len(ws))
+	for _, w := range ws {
+		out = append(out, &ai.VerificationWarning{Kind: w.Kind, Message: w.Message, Cited: w.Cited, Expected: w.Expected, Model: w.Model})
+	}
+	return out
+}
+
+func toEnsemble(e *predictor.Ensemble) *ai.Ensemble {
+	if e == nil {
+		return nil
+	}
+	out := &ai.Ensemble{Votes: e.Votes, Agreement: e.Agreement, Members: make([]*ai.EnsembleMember, 0, len(e.Members))}
+	for _, m := range e.Members {
+		member := &ai.EnsembleMember{Model: m.Model, Weight: m.Weight, Accuracy: m.Accuracy, Samples: int32(m.Samples), Error: m.Error}
+		if m.Result != nil {
+			member.Result_ = toPredictionResult(m.Result)
+		}
+		out.Members = append(out.Members, member)
+	}
+	return out
+}
+
+func toPriceBands(b *quant.Bands) *ai.PriceBands {
+	if b == nil {
+		return nil
+	}
+	out := &ai.PriceBands{
+		Model:       b.Model,
+		Days:        int32(b.Days),
+		Paths:       int32(b.Paths),
+		Lookback:    int32(b.Lookback),
+		Price:       b.Price,
+		DailyVolPct: b.DailyVolPct,
+		DriftPct:    b.DriftPct,
+		Points:      make([]*ai.PriceBandPoint, 0, len(b.Points)),
+	}
+	for _, p := range b.Points {
+		out.Points = append(out.Points, &ai.PriceBandPoint{Day: int32(p.Day), P5: p.P5, P25: p.P25, P50: p.P50, P75: p.P75, P95: p.P95})
+	}
+	return out
+}
+
+func toDebate(d *predictor.Debate) *ai.Debate {
+	if d == nil {
+		return nil
+	}
+	return &ai.Debate{Bull: d.Bull, Bear: d.Bear}
+}
+
+func toToolInvocations(calls []predictor.ToolInvocation) []*ai.ToolInvocation {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]*ai.ToolInvocation, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, &ai.ToolInvocation{
+			Role:      c.Role,
+			Model:     c.Model,
+			Step:      int32(c.Step),
+			Name:      c.Name,
+			Arguments: c.Arguments,
+			Result_:   c.Result,
+			Error:     c.Error,
+			ElapsedMs: c.ElapsedMs,
+		})
+	}
+	return out
+}
+
+func toVerdict(v *predictor.Verdict) *ai.Verdict {
+	if v == nil {
+		return nil
+	}
+	return &ai.Verdict{
+		Direction:     v.Direction,
+		Confidence:    v.Confidence,
+		ChangeLowPct:  v.ChangeLowPct,
+		ChangeHighPct: v.ChangeHighPct,
+		PriceLow:      v.PriceLow,
+		PriceHigh:     v.PriceHigh,
+	}
+}
+
+func toTechnicalIndicators(t *predictor.Technical) *ai.TechnicalIndicators {
+	if t == nil {
+		return nil
+	}
+	return &ai.TechnicalIndicators{
+		AsOf:        t.AsOf,
+		Bars:        int32(t.Bars),
+		Close:       t.Close,
+		Ma5:         t.MA5,
+		Ma10:        t.MA10,
+		Ma20:        t.MA20,
+		Ma60:        t.MA60,
+		MacdDif:     t.MACDDif,
+		MacdDea:     t.MACDDea,
+		MacdHist:    t.MACDHist,
+		Rsi6:        t.RSI6,
+		Rsi12:       t.RSI12,
+		Rsi24:       t.RSI24,
+		KdjK:        t.KDJK,
+		KdjD:        t.KDJD,
+		KdjJ:        t.KDJJ,
+		BollUpper:   t.BollUpper,
+		BollMid:     t.BollMid,
+		BollLower:   t.BollLower,
+		VolumeRatio: t.VolumeRatio,
+		High20:      t.High20,
+		Low20:       t.Low20,
+	}
+}
+
+// CreateChatSession 创建追问会话，之后的对话经 HTTP 流式接口 /chat/messages 进行
+func (s *AIServiceImpl) CreateChatSession(ctx context.Context, req *ai.CreateChatSessionRequest) (*ai.CreateChatSessionResponse, error) {
+	log.Printf("CreateChatSession: code=%s prediction_id=%s", req.Code, req.PredictionId)
+	sess, err := s.predictor.CreateChatSession(ctx, req.Code, req.PredictionId, req.Model)
+	if err != nil {
+		return nil, i18n.LocalizeError(err, req.GetLanguage())
+	}
+	return &ai.CreateChatSessionResponse{Session: toChatSession(sess)}, nil
+}
+
+// GetChatSession 读取会话，不存在时 session 为空
+func (s *AIServiceImpl) GetChatSession(ctx context.Context, req *ai.GetChatSessionRequest) (*ai.GetChatSessionResponse, error) {
+	sess, err := s.predictor.ChatSession(req.Id)
+	if errors.Is(err, chat.ErrNotFound) {
+		return &ai.GetChatSessionResponse{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &ai.GetPredictionResponse{
-		Result_: &ai.PredictionResult_{
-			Code:         req.Code,
-			Confidence:   confidence,
-			Analysis:     analysis,
-			NewsSummary_: newsSummary,
-		},
-	}, nil
+	return &ai.GetChatSessionResponse{Session: toChatSession(sess)}, nil
+}
+
+func toChatSession(sess *chat.Session) *ai.ChatSession {
+	msgs := make([]*ai.ChatMessage, 0, len(sess.Messages))
+	for _, m := range sess.Messages {
+		msgs = append(msgs, &ai.ChatMessage{Role: m.Role, Content: m.Content, Time: m.Time.Format(time.RFC3339)})
+	}
+	return &ai.ChatSession{
+		Id:           sess.ID,
+		Code:         sess.Code,
+		PredictionId: sess.PredictionID,
+		Model:        sess.Model,
+		Messages:     msgs,
+		CreatedAt:    sess.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    sess.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// SubmitPredictionJob 提交异步预测任务，立即返回任务（排队中）
+func (s *AIServiceImpl) SubmitPredictionJob(ctx context.Context, req *ai.SubmitPredictionJobRequest) (*ai.SubmitPredictionJobResponse, error) {
+	if req.Prediction == nil || req.Prediction.Code == "" {
+		lang := ""
+		if req.Prediction != nil {
+			lang = req.Prediction.Language
+		}
+		return nil, i18n.LocalizeError(i18n.Errorf(i18n.MsgCodeRequired), lang)
+	}
+	if err := predictor.CheckDays(req.Prediction.Days); err != nil {
+		return nil, i18n.LocalizeError(err, req.Prediction.Language)
+	}
+	log.Printf("SubmitPredictionJob: code=%s", req.Prediction.Code)
+	j, err := s.jobs.Submit(toPredictorRequest(req.Prediction))
+	if err != nil {
+		return nil, i18n.LocalizeError(err, req.Prediction.Language)
+	}
+	return &ai.SubmitPredictionJobResponse{Job: toPredictionJob(j)}, nil
+}
+
+// GetPredictionJob 查询任务状态与结果，不存在时 job 为空
+func (s *AIServiceImpl) GetPredictionJob(ctx context.Context, req *ai.GetPredictionJobRequest) (*ai.GetPredictionJobResponse, error) {
+	j, err := s.jobs.Get(req.Id)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return &ai.GetPredictionJobResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ai.GetPredictionJobResponse{Job: toPredictionJob(j)}, nil
+}
+
+// CancelPredictionJob 取消任务，不存在时 job 为空
+func (s *AIServiceImpl) CancelPredictionJob(ctx context.Context, req *ai.CancelPredictionJobRequest) (*ai.CancelPredictionJobResponse, error) {
+	log.Printf("CancelPredictionJob: id=%s", req.Id)
+	j, err := s.jobs.Cancel(req.Id)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return &ai.CancelPredictionJobResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ai.CancelPredictionJobResponse{Job: toPredictionJob(j)}, nil
+}
+
+// GetUsage 最近 days 天的 LLM 用量汇总与每日预算状态
+func (s *AIServiceImpl) GetUsage(ctx context.Context, req *ai.GetUsageRequest) (*ai.GetUsageResponse, error) {
+	u := s.predictor.Usage()
+	days := u.Summary(int(req.Days))
+	out := &ai.GetUsageResponse{Days: make([]*ai.UsageDay, 0, len(days))}
+	for _, d := range days {
+		total := d.Total
+		out.Days = append(out.Days, &ai.UsageDay{
+			Date:       d.Date,
+			Total:      toUsageTotals(&total),
+			ByModel:    toUsageTotalsList(d.ByModel),
+			ByEndpoint: toUsageTotalsList(d.ByEndpoint),
+		})
+	}
+	b := u.Budget()
+	out.Budget = &ai.UsageBudget{
+		Daily:         b.Daily,
+		Action:        b.Action,
+		FallbackModel: b.FallbackModel,
+		TodayCost:     b.TodayCost,
+		Exceeded:      b.Exceeded,
+		Currency:      b.Currency,
+	}
+	return out, nil
+}
+
+// GetCalibration 置信度校准结果与可靠性图数据；refit 为 true 时先重新评估并拟合
+func (s *AIServiceImpl) GetCalibration(ctx context.Context, req *ai.GetCalibrationRequest) (*ai.GetCalibrationResponse, error) {
+	c := s.predictor.Calibration()
+	st := c.State()
+	if req.Refit {
+		var err error
+		if st, err = c.Refit(ctx); err != nil {
+			return nil, err
+		}
+	}
+	out := &ai.GetCalibrationResponse{Groups: []*ai.CalibrationGroup{}}
+	if st == nil {
+		return out, nil
+	}
+	out.FittedAt = formatTime(st.FittedAt)
+	out.Samples, out.Pending, out.MinSamples = int32(st.Samples), int32(st.Pending), int32(st.MinSamples)
+	for _, g := range st.Groups {
+		out.Groups = append(out.Groups, toCalibrationGroup(g))
+	}
+	return out, nil
+}
+
+// GetMarketCommentary 当前市场时段的 AI 大市点评（同一时段只生成一次，见 predictor.MarketCommentary）
+func (s *AIServiceImpl) GetMarketCommentary(ctx context.Context, req *ai.GetMarketCommentaryRequest) (*ai.GetMarketCommentaryResponse, error) {
+	c, err := s.predictor.MarketCommentary(usage.WithEndpoint(ctx, "rpc/GetMarketCommentary"), predictor.MarketCommentaryRequest{
+		Language:     req.Language,
+		ForceRefresh: req.ForceRefresh,
+	})
+	if err != nil {
+		return nil, i18n.LocalizeError(err, req.Language)
+	}
+	out := &ai.MarketCommentary{
+		SessionDate:     c.SessionDate,
+		Phase:           c.Phase,
+		Commentary:      c.Commentary,
+		Model:           c.Model,
+		TemplateVersion: c.TemplateVersion,
+		Language:        c.Language,
+		GeneratedAt:     formatTime(c.GeneratedAt),
+		ExpiresAt:       formatTime(c.ExpiresAt),
+		Cached:          c.Cached,
+	}
+	if c.Overview != nil {
+		out.DataWarnings = c.Overview.Warnings
+	}
+	return &ai.GetMarketCommentaryResponse{Commentary: out}, nil
+}
+
+// ComparePrediction 2～5 只股票的横向对比分析与排名，各股给出结构化结论
+func (s *AIServiceImpl) ComparePrediction(ctx context.Context, req *ai.ComparePredictionRequest) (*ai.ComparePredictionResponse, error) {
+	res, err := s.predictor.Compare(usage.WithEndpoint(ctx, "rpc/ComparePrediction"), predictor.CompareRequest{
+		Codes:           req.Codes,
+		Days:            req.Days,
+		Model:           req.Model,
+		TemplateVersion: req.TemplateVersion,
+		Language:        req.Language,
+	})
+	if err != nil {
+		return nil, i18n.LocalizeError(err, req.Language)
+	}
+	out := &ai.ComparePredictionResponse{
+		Days:            res.Days,
+		Model:           res.Model,
+		TemplateVersion: res.TemplateVersion,
+		Language:        res.Language,
+		Analysis:        res.Analysis,
+		Stocks:          make([]*ai.CompareStock, 0, len(res.Stocks)),
+		Warnings:        toVerificationWarnings(res.Warnings),
+	}
+	for _, st := range res.Stocks {
+		out.Stocks = append(out.Stocks, &ai.CompareStock{
+			Code:          st.Code,
+			Name:          st.Name,
+			Price:         st.Price,
+			ChangePercent: st.ChangePercent,
+			Rank:          int32(st.Rank),
+			Verdict:       toVerdict(st.Verdict),
+			Reason:        st.Reason,
+			Technical:     toTechnicalIndicators(st.Technical),
+			Bands:         toPriceBands(st.Bands),
+			PredictionId:  st.PredictionID,
+			Context:       toDataSnapshot(st.Context),
+		})
+	}
+	return out, nil
+}
+
+func toCalibrationGroup(g *calibration.Group) *ai.CalibrationGroup {
+	out := &ai.CalibrationGroup{
+		Model:           g.Model,
+		TemplateVersion: g.TemplateVersion,
+		Samples:         int32(g.Samples),
+		Rated:           int32(g.Rated),
+		BaseRate:        g.BaseRate,
+		BrierRaw:        g.BrierRaw,
+		BrierCalibrated: g.BrierCalibrated,
+		Curve:           make([]*ai.CalibrationPoint, 0, len(g.Curve)),
+		Bins:            make([]*ai.CalibrationBin, 0, len(g.Bins)),
+	}
+	for _, p := range g.Curve {
+		out.Curve = append(out.Curve, &ai.CalibrationPoint{Raw: p.Raw, Calibrated: p.Calibrated})
+	}
+	for _, b := range g.Bins {
+		out.Bins = append(out.Bins, &ai.CalibrationBin{
+			Low: b.Low, High: b.High, Count: int32(b.Count), AvgRaw: b.AvgRaw, AvgCalibrated: b.AvgCalibrated, HitRate: b.HitRate,
+		})
+	}
+	return out
+}
+
+func toUsageTotals(t *usage.Totals) *ai.UsageTotals {
+	return &ai.UsageTotals{
+		Key:              t.Key,
+		Calls:            t.Calls,
+		PromptTokens:     t.PromptTokens,
+		CompletionTokens: t.CompletionTokens,
+		ReasoningTokens:  t.ReasoningTokens,
+		TotalTokens:      t.TotalTokens,
+		Cost:             t.Cost,
+		EstimatedCalls:   t.EstimatedCalls,
+		Unpriced:         t.Unpriced,
+	}
+}
+
+func toUsageTotalsList(list []*usage.Totals) []*ai.UsageTotals {
+	out := make([]*ai.UsageTotals, 0, len(list))
+	for _, t := range list {
+		out = append(out, toUsageTotals(t))
+	}
+	return out
+}
+
+func toPredictionJob(j *jobs.Job) *ai.PredictionJob {
+	out := &ai.PredictionJob{
+		Id:            j.ID,
+		Status:        j.Status,
+		Stage:         j.Stage,
+		Progress:      int32(j.Progress),
+		Code:          j.Request.Code,
+		CreatedAt:     formatTime(j.CreatedAt),
+		StartedAt:     formatTime(j.StartedAt),
+		FinishedAt:    formatTime(j.FinishedAt),
+		Error:         j.Error,
+		QueuePosition: int32(j.QueuePosition),
+	}
+	if j.Result != nil {
+		out.Result_ = toPredictionResult(j.Result)
+	}
+	return out
+}
+
+// formatTime RFC3339，零值返回空串
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
 }
@@ -20,6 +20,69 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateChatSession": kitex.NewMethodInfo(
+		createChatSessionHandler,
+		newAIServiceCreateChatSessionArgs,
+		newAIServiceCreateChatSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetChatSession": kitex.NewMethodInfo(
+		getChatSessionHandler,
+		newAIServiceGetChatSessionArgs,
+		newAIServiceGetChatSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubmitPredictionJob": kitex.NewMethodInfo(
+		submitPredictionJobHandler,
+		newAIServiceSubmitPredictionJobArgs,
+		newAIServiceSubmitPredictionJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPredictionJob": kitex.NewMethodInfo(
+		getPredictionJobHandler,
+		newAIServiceGetPredictionJobArgs,
+		newAIServiceGetPredictionJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelPredictionJob": kitex.NewMethodInfo(
+		cancelPredictionJobHandler,
+		newAIServiceCancelPredictionJobArgs,
+		newAIServiceCancelPredictionJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUsage": kitex.NewMethodInfo(
+		getUsageHandler,
+		newAIServiceGetUsageArgs,
+		newAIServiceGetUsageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCalibration": kitex.NewMethodInfo(
+		getCalibrationHandler,
+		newAIServiceGetCalibrationArgs,
+		newAIServiceGetCalibrationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarketCommentary": kitex.NewMethodInfo(
+		getMarketCommentaryHandler,
+		newAIServiceGetMarketCommentaryArgs,
+		newAIServiceGetMarketCommentaryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ComparePrediction": kitex.NewMethodInfo(
+		comparePredictionHandler,
+		newAIServiceComparePredictionArgs,
+		newAIServiceComparePredictionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -104,6 +167,168 @@ func newAIServiceGetPredictionResult() interface{} {
 	return ai.NewAIServiceGetPredictionResult()
 }
 
+func createChatSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceCreateChatSessionArgs)
+	realResult := result.(*ai.AIServiceCreateChatSessionResult)
+	success, err := handler.(ai.AIService).CreateChatSession(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceCreateChatSessionArgs() interface{} {
+	return ai.NewAIServiceCreateChatSessionArgs()
+}
+
+func newAIServiceCreateChatSessionResult() interface{} {
+	return ai.NewAIServiceCreateChatSessionResult()
+}
+
+func getChatSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceGetChatSessionArgs)
+	realResult := result.(*ai.AIServiceGetChatSessionResult)
+	success, err := handler.(ai.AIService).GetChatSession(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceGetChatSessionArgs() interface{} {
+	return ai.NewAIServiceGetChatSessionArgs()
+}
+
+func newAIServiceGetChatSessionResult() interface{} {
+	return ai.NewAIServiceGetChatSessionResult()
+}
+
+func submitPredictionJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceSubmitPredictionJobArgs)
+	realResult := result.(*ai.AIServiceSubmitPredictionJobResult)
+	success, err := handler.(ai.AIService).SubmitPredictionJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceSubmitPredictionJobArgs() interface{} {
+	return ai.NewAIServiceSubmitPredictionJobArgs()
+}
+
+func newAIServiceSubmitPredictionJobResult() interface{} {
+	return ai.NewAIServiceSubmitPredictionJobResult()
+}
+
+func getPredictionJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceGetPredictionJobArgs)
+	realResult := result.(*ai.AIServiceGetPredictionJobResult)
+	success, err := handler.(ai.AIService).GetPredictionJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceGetPredictionJobArgs() interface{} {
+	return ai.NewAIServiceGetPredictionJobArgs()
+}
+
+func newAIServiceGetPredictionJobResult() interface{} {
+	return ai.NewAIServiceGetPredictionJobResult()
+}
+
+func cancelPredictionJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceCancelPredictionJobArgs)
+	realResult := result.(*ai.AIServiceCancelPredictionJobResult)
+	success, err := handler.(ai.AIService).CancelPredictionJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceCancelPredictionJobArgs() interface{} {
+	return ai.NewAIServiceCancelPredictionJobArgs()
+}
+
+func newAIServiceCancelPredictionJobResult() interface{} {
+	return ai.NewAIServiceCancelPredictionJobResult()
+}
+
+func getUsageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceGetUsageArgs)
+	realResult := result.(*ai.AIServiceGetUsageResult)
+	success, err := handler.(ai.AIService).GetUsage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceGetUsageArgs() interface{} {
+	return ai.NewAIServiceGetUsageArgs()
+}
+
+func newAIServiceGetUsageResult() interface{} {
+	return ai.NewAIServiceGetUsageResult()
+}
+
+func getCalibrationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceGetCalibrationArgs)
+	realResult := result.(*ai.AIServiceGetCalibrationResult)
+	success, err := handler.(ai.AIService).GetCalibration(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceGetCalibrationArgs() interface{} {
+	return ai.NewAIServiceGetCalibrationArgs()
+}
+
+func newAIServiceGetCalibrationResult() interface{} {
+	return ai.NewAIServiceGetCalibrationResult()
+}
+
+func getMarketCommentaryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceGetMarketCommentaryArgs)
+	realResult := result.(*ai.AIServiceGetMarketCommentaryResult)
+	success, err := handler.(ai.AIService).GetMarketCommentary(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceGetMarketCommentaryArgs() interface{} {
+	return ai.NewAIServiceGetMarketCommentaryArgs()
+}
+
+func newAIServiceGetMarketCommentaryResult() interface{} {
+	return ai.NewAIServiceGetMarketCommentaryResult()
+}
+
+func comparePredictionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*ai.AIServiceComparePredictionArgs)
+	realResult := result.(*ai.AIServiceComparePredictionResult)
+	success, err := handler.(ai.AIService).ComparePrediction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAIServiceComparePredictionArgs() interface{} {
+	return ai.NewAIServiceComparePredictionArgs()
+}
+
+func newAIServiceComparePredictionResult() interface{} {
+	return ai.NewAIServiceComparePredictionResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -123,3 +348,93 @@ func (p *kClient) GetPrediction(ctx context.Context, req *ai.GetPredictionReques
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateChatSession(ctx context.Context, req *ai.CreateChatSessionRequest) (r *ai.CreateChatSessionResponse, err error) {
+	var _args ai.AIServiceCreateChatSessionArgs
+	_args.Req = req
+	var _result ai.AIServiceCreateChatSessionResult
+	if err = p.c.Call(ctx, "CreateChatSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetChatSession(ctx context.Context, req *ai.GetChatSessionRequest) (r *ai.GetChatSessionResponse, err error) {
+	var _args ai.AIServiceGetChatSessionArgs
+	_args.Req = req
+	var _result ai.AIServiceGetChatSessionResult
+	if err = p.c.Call(ctx, "GetChatSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitPredictionJob(ctx context.Context, req *ai.SubmitPredictionJobRequest) (r *ai.SubmitPredictionJobResponse, err error) {
+	var _args ai.AIServiceSubmitPredictionJobArgs
+	_args.Req = req
+	var _result ai.AIServiceSubmitPredictionJobResult
+	if err = p.c.Call(ctx, "SubmitPredictionJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPredictionJob(ctx context.Context, req *ai.GetPredictionJobRequest) (r *ai.GetPredictionJobResponse, err error) {
+	var _args ai.AIServiceGetPredictionJobArgs
+	_args.Req = req
+	var _result ai.AIServiceGetPredictionJobResult
+	if err = p.c.Call(ctx, "GetPredictionJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelPredictionJob(ctx context.Context, req *ai.CancelPredictionJobRequest) (r *ai.CancelPredictionJobResponse, err error) {
+	var _args ai.AIServiceCancelPredictionJobArgs
+	_args.Req = req
+	var _result ai.AIServiceCancelPredictionJobResult
+	if err = p.c.Call(ctx, "CancelPredictionJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUsage(ctx context.Context, req *ai.GetUsageRequest) (r *ai.GetUsageResponse, err error) {
+	var _args ai.AIServiceGetUsageArgs
+	_args.Req = req
+	var _result ai.AIServiceGetUsageResult
+	if err = p.c.Call(ctx, "GetUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCalibration(ctx context.Context, req *ai.GetCalibrationRequest) (r *ai.GetCalibrationResponse, err error) {
+	var _args ai.AIServiceGetCalibrationArgs
+	_args.Req = req
+	var _result ai.AIServiceGetCalibrationResult
+	if err = p.c.Call(ctx, "GetCalibration", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarketCommentary(ctx context.Context, req *ai.GetMarketCommentaryRequest) (r *ai.GetMarketCommentaryResponse, err error) {
+	var _args ai.AIServiceGetMarketCommentaryArgs
+	_args.Req = req
+	var _result ai.AIServiceGetMarketCommentaryResult
+	if err = p.c.Call(ctx, "GetMarketCommentary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ComparePrediction(ctx context.Context, req *ai.ComparePredictionRequest) (r *ai.ComparePredictionResponse, err error) {
+	var _args ai.AIServiceComparePredictionArgs
+	_args.Req = req
+	var _result ai.AIServiceComparePredictionResult
+	if err = p.c.Call(ctx, "ComparePrediction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
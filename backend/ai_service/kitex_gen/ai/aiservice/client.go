@@ -12,6 +12,15 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	GetPrediction(ctx context.Context, req *ai.GetPredictionRequest, callOptions ...callopt.Option) (r *ai.GetPredictionResponse, err error)
+	CreateChatSession(ctx context.Context, req *ai.CreateChatSessionRequest, callOptions ...callopt.Option) (r *ai.CreateChatSessionResponse, err error)
+	GetChatSession(ctx context.Context, req *ai.GetChatSessionRequest, callOptions ...callopt.Option) (r *ai.GetChatSessionResponse, err error)
+	SubmitPredictionJob(ctx context.Context, req *ai.SubmitPredictionJobRequest, callOptions ...callopt.Option) (r *ai.SubmitPredictionJobResponse, err error)
+	GetPredictionJob(ctx context.Context, req *ai.GetPredictionJobRequest, callOptions ...callopt.Option) (r *ai.GetPredictionJobResponse, err error)
+	CancelPredictionJob(ctx context.Context, req *ai.CancelPredictionJobRequest, callOptions ...callopt.Option) (r *ai.CancelPredictionJobResponse, err error)
+	GetUsage(ctx context.Context, req *ai.GetUsageRequest, callOptions ...callopt.Option) (r *ai.GetUsageResponse, err error)
+	GetCalibration(ctx context.Context, req *ai.GetCalibrationRequest, callOptions ...callopt.Option) (r *ai.GetCalibrationResponse, err error)
+	GetMarketCommentary(ctx context.Context, req *ai.GetMarketCommentaryRequest, callOptions ...callopt.Option) (r *ai.GetMarketCommentaryResponse, err error)
+	ComparePrediction(ctx context.Context, req *ai.ComparePredictionRequest, callOptions ...callopt.Option) (r *ai.ComparePredictionResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +56,48 @@ func (p *kAIServiceClient) GetPrediction(ctx context.Context, req *ai.GetPredict
 	return p.kClient.GetPrediction(ctx, req)
 }
 
+func (p *kAIServiceClient) CreateChatSession(ctx context.Context, req *ai.CreateChatSessionRequest, callOptions ...callopt.Option) (r *ai.CreateChatSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateChatSession(ctx, req)
+}
+
+func (p *kAIServiceClient) GetChatSession(ctx context.Context, req *ai.GetChatSessionRequest, callOptions ...callopt.Option) (r *ai.GetChatSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetChatSession(ctx, req)
+}
+
+func (p *kAIServiceClient) SubmitPredictionJob(ctx context.Context, req *ai.SubmitPredictionJobRequest, callOptions ...callopt.Option) (r *ai.SubmitPredictionJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitPredictionJob(ctx, req)
+}
+
+func (p *kAIServiceClient) GetPredictionJob(ctx context.Context, req *ai.GetPredictionJobRequest, callOptions ...callopt.Option) (r *ai.GetPredictionJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPredictionJob(ctx, req)
+}
+
+func (p *kAIServiceClient) CancelPredictionJob(ctx context.Context, req *ai.CancelPredictionJobRequest, callOptions ...callopt.Option) (r *ai.CancelPredictionJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelPredictionJob(ctx, req)
+}
+
+func (p *kAIServiceClient) GetUsage(ctx context.Context, req *ai.GetUsageRequest, callOptions ...callopt.Option) (r *ai.GetUsageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUsage(ctx, req)
+}
+
+func (p *kAIServiceClient) GetCalibration(ctx context.Context, req *ai.GetCalibrationRequest, callOptions ...callopt.Option) (r *ai.GetCalibrationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCalibration(ctx, req)
+}
+
+func (p *kAIServiceClient) GetMarketCommentary(ctx context.Context, req *ai.GetMarketCommentaryRequest, callOptions ...callopt.Option) (r *ai.GetMarketCommentaryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarketCommentary(ctx, req)
+}
+
+func (p *kAIServiceClient) ComparePrediction(ctx context.Context, req *ai.ComparePredictionRequest, callOptions ...callopt.Option) (r *ai.ComparePredictionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ComparePrediction(ctx, req)
+}
+
@@ -11,6 +11,7 @@ import (
 	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/server"
 	ai "hk_stock_assistant/backend/ai_service/kitex_gen/ai/aiservice"
+	"hk_stock_assistant/backend/ai_service/biz/jobs"
 	"hk_stock_assistant/backend/ai_service/biz/predictor"
 	"hk_stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
 )
@@ -54,9 +55,11 @@ func main() {
 		log.Fatalf("init stock client: %v", err)
 	}
 	p := predictor.New(stockClient)
-	go RunStreamServer(p)
+	p.Calibration().Start()
+	jm := jobs.NewManager(p)
+	go RunStreamServer(p, jm)
 	addr, _ := net.ResolveTCPAddr("tcp", ":8889")
-	svr := ai.NewServer(NewAIServiceImpl(stockClient, p), server.WithServiceAddr(addr))
+	svr := ai.NewServer(NewAIServiceImpl(stockClient, p, jm), server.WithServiceAddr(addr))
 	if err := svr.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -1,20 +1,32 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 
+	"hk_stock_assistant/backend/ai_service/biz/i18n"
+	"hk_stock_assistant/backend/ai_service/biz/jobs"
+	"hk_stock_assistant/backend/ai_service/biz/metrics"
 	"hk_stock_assistant/backend/ai_service/biz/predictor"
+	"hk_stock_assistant/backend/ai_service/biz/usage"
 )
 
 const streamAddr = ":8890"
 
 // RunStreamServer 启动 HTTP 流式预测服务，供网关代理 SSE。
-func RunStreamServer(p *predictor.Predictor) {
+func RunStreamServer(p *predictor.Predictor, jm *jobs.Manager) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) { handleStream(p, w, r) })
+	mux.HandleFunc("/chat/messages", func(w http.ResponseWriter, r *http.Request) { handleChatStream(p, w, r) })
+	mux.HandleFunc("/batch", func(w http.ResponseWriter, r *http.Request) { handleBatchStream(p, w, r) })
+	mux.HandleFunc("/jobs/stream", func(w http.ResponseWriter, r *http.Request) { handleJobStream(jm, w, r) })
+	mux.HandleFunc("/market/commentary", func(w http.ResponseWriter, r *http.Request) { handleCommentaryStream(p, w, r) })
+	mux.HandleFunc("/metrics", metrics.Handler)
 	log.Printf("[stream] listening on %s", streamAddr)
 	if err := http.ListenAndServe(streamAddr, mux); err != nil {
 		log.Printf("[stream] server error: %v", err)
@@ -28,12 +40,18 @@ func handleStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request
 	}
 	code := strings.TrimSpace(r.URL.Query().Get("code"))
 	days := int32(3)
-	modelOverride := ""
+	modelOverride, templateVersion, mode, language, forceRefresh := "", "", "", "", false
+	var models []string
 	if r.Method == http.MethodPost && r.Body != nil {
 		var body struct {
-			Code  string `json:"code"`
-			Days  int32  `json:"days"`
-			Model string `json:"model"`
+			Code            string   `json:"code"`
+			Days            int32    `json:"days"`
+			Model           string   `json:"model"`
+			TemplateVersion string   `json:"template_version"`
+			Mode            string   `json:"mode"`
+			ForceRefresh    bool     `json:"force_refresh"`
+			Models          []string `json:"models"`
+			Language        string   `json:"language"`
 		}
 		_ = json.NewDecoder(r.Body).Decode(&body)
 		r.Body.Close()
@@ -42,6 +60,11 @@ func handleStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request
 			days = body.Days
 		}
 		modelOverride = strings.TrimSpace(body.Model)
+		templateVersion = strings.TrimSpace(body.TemplateVersion)
+		mode = strings.TrimSpace(body.Mode)
+		forceRefresh = body.ForceRefresh
+		models = body.Models
+		language = strings.TrimSpace(body.Language)
 	} else {
 		// GET: code 来自 query，days/model 可扩展
 		if code == "" {
@@ -53,29 +76,227 @@ func handleStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request
 		http.Error(w, "missing code", http.StatusBadRequest)
 		return
 	}
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("X-Accel-Buffering", "no")
-	flusher, ok := w.(http.Flusher)
+	if err := predictor.CheckDays(days); err != nil {
+		http.Error(w, i18n.Localize(err, language), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := startSSE(w)
 	if !ok {
-		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
 		return
 	}
 	if p == nil {
 		writeSSE(w, flusher, "error", "predictor not initialized")
 		return
 	}
-	err := p.StreamPredict(r.Context(), code, days, modelOverride, func(eventType string, chunk string) error {
-		return writeSSE(w, flusher, eventType, chunk)
-	})
+	req := predictor.Request{Code: code, Days: days, Model: modelOverride, TemplateVersion: templateVersion, Mode: mode, ForceRefresh: forceRefresh, Models: models, Language: language}
+	ctx, emit := clientContext(r, sseEvents(w, flusher))
+	_, err := p.StreamPredict(ctx, req, emit)
+	countStream(r, err)
+	if err != nil {
+		writeSSE(w, flusher, "error", i18n.Localize(err, language))
+		return
+	}
+	writeSSE(w, flusher, "done", "")
+}
+
+// handleChatStream POST /chat/messages，body: {session_id, content, language}，language 仅决定错误文案的语言。事件：reasoning、content、tool、message（助手回复 JSON）、done、error。
+func handleChatStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		SessionID string `json:"session_id"`
+		Content   string `json:"content"`
+		Language  string `json:"language"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	r.Body.Close()
+	if strings.TrimSpace(body.Content) == "" {
+		http.Error(w, i18n.Localize(i18n.Errorf(i18n.MsgContentRequired), body.Language), http.StatusBadRequest)
+		return
+	}
+	if _, err := p.ChatSession(body.SessionID); err != nil {
+		http.Error(w, i18n.Localize(err, body.Language), http.StatusNotFound)
+		return
+	}
+	flusher, ok := startSSE(w)
+	if !ok {
+		return
+	}
+	ctx, emit := clientContext(r, sseEvents(w, flusher))
+	reply, err := p.Chat(ctx, body.SessionID, body.Content, emit)
+	countStream(r, err)
+	if err != nil {
+		writeSSE(w, flusher, "error", i18n.Localize(err, body.Language))
+		return
+	}
+	writeSSEJSON(w, flusher, "message", reply)
+	writeSSE(w, flusher, "done", "")
+}
+
+// handleBatchStream POST /batch，body: {codes, days, model, template_version, mode, language}。
+// 事件：item（每只股票的结果或错误）、digest（汇总排序与 Markdown 文档）、done、error。
+func handleBatchStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req predictor.BatchRequest
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	r.Body.Close()
+	if len(req.Codes) == 0 {
+		http.Error(w, i18n.Localize(i18n.Errorf(i18n.MsgBatchNoCodes), req.Language), http.StatusBadRequest)
+		return
+	}
+	if err := predictor.CheckDays(req.Days); err != nil {
+		http.Error(w, i18n.Localize(err, req.Language), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := startSSE(w)
+	if !ok {
+		return
+	}
+	ctx, emit := clientContext(r, sseEvents(w, flusher))
+	_, err := p.BatchPredict(ctx, req, emit)
+	countStream(r, err)
 	if err != nil {
-		writeSSE(w, flusher, "error", err.Error())
+		writeSSE(w, flusher, "error", i18n.Localize(err, req.Language))
 		return
 	}
 	writeSSE(w, flusher, "done", "")
 }
 
+// handleJobStream POST /jobs/stream，body: {id, language}。接入异步任务的事件流：运行中的任务从头重放并持续推送，
+// 已完成的任务重放 content 与 result。事件同 /stream，另以 job 事件先发送任务当前状态。
+// 错误文案按 language，为空时按提交任务时的语言（任务失败的错误在结束时已按该语言保存）。
+func handleJobStream(jm *jobs.Manager, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		ID       string `json:"id"`
+		Language string `json:"language"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	r.Body.Close()
+	j, err := jm.Get(strings.TrimSpace(body.ID))
+	if err != nil {
+		http.Error(w, i18n.Localize(err, body.Language), http.StatusNotFound)
+		return
+	}
+	lang := body.Language
+	if lang == "" {
+		lang = j.Request.Language
+	}
+	flusher, ok := startSSE(w)
+	if !ok {
+		return
+	}
+	writeSSEJSON(w, flusher, "job", j)
+	err = jm.Attach(r.Context(), j.ID, sseEvents(w, flusher))
+	switch {
+	case errors.Is(err, context.Canceled) && r.Context().Err() == nil:
+		writeSSE(w, flusher, "error", i18n.Localize(i18n.Errorf(i18n.MsgJobCanceled), lang))
+	case err != nil:
+		writeSSE(w, flusher, "error", i18n.Localize(err, lang))
+	default:
+		writeSSE(w, flusher, "done", "")
+	}
+}
+
+// handleCommentaryStream POST /market/commentary，body: {language, force_refresh}。
+// 事件：overview（市场概览数据）、reasoning、content、commentary（完整点评 JSON）、done、error。
+// 同一时段已生成时直接重放；生成与请求解绑，客户端断开不会取消本时段的生成。
+func handleCommentaryStream(p *predictor.Predictor, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req predictor.MarketCommentaryRequest
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	r.Body.Close()
+	flusher, ok := startSSE(w)
+	if !ok {
+		return
+	}
+	ctx, emit := clientContext(r, sseEvents(w, flusher))
+	_, err := p.StreamMarketCommentary(ctx, req, emit)
+	countStream(r, err)
+	if err != nil {
+		writeSSE(w, flusher, "error", i18n.Localize(err, req.Language))
+		return
+	}
+	writeSSE(w, flusher, "done", "")
+}
+
+func init() {
+	metrics.Describe("ai_stream_requests_total", "流式接口请求数，outcome: completed/error/client_gone（客户端断开并已取消）/detached（客户端断开后继续完成）")
+}
+
+// finishOnDisconnect AI_CANCEL_MODE=complete 时客户端断开后继续完成 LLM 调用（结果照常写入预测记录与会话），
+// 默认 cancel：立即取消排队与进行中的 LLM 请求。
+func finishOnDisconnect() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv("AI_CANCEL_MODE")), "complete")
+}
+
+// clientContext 预测使用的 context 与事件回调：默认随客户端断开取消；complete 模式下与请求解绑，
+// 客户端断开后不再推送事件，但预测继续执行至结束。LLM 用量按请求路径归入对应入口。
+func clientContext(r *http.Request, emit predictor.EventFunc) (context.Context, predictor.EventFunc) {
+	ctx := usage.WithEndpoint(r.Context(), r.URL.Path)
+	if !finishOnDisconnect() {
+		return ctx, emit
+	}
+	return context.WithoutCancel(ctx), func(ev predictor.Event) error {
+		if r.Context().Err() != nil {
+			return nil
+		}
+		_ = emit(ev)
+		return nil
+	}
+}
+
+// countStream 按结果统计流式请求
+func countStream(r *http.Request, err error) {
+	outcome := "completed"
+	switch {
+	case r.Context().Err() != nil && err == nil:
+		outcome = "detached"
+	case r.Context().Err() != nil:
+		outcome = "client_gone"
+	case err != nil:
+		outcome = "error"
+	}
+	if outcome == "client_gone" || outcome == "detached" {
+		log.Printf("[stream] %s client disconnected (%s)", r.URL.Path, outcome)
+	}
+	metrics.Inc("ai_stream_requests_total", "endpoint", r.URL.Path, "outcome", outcome)
+}
+
+// startSSE 写入 SSE 响应头；不支持 Flush 时返回 false 并已写入错误响应
+func startSSE(w http.ResponseWriter) (http.Flusher, bool) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+	}
+	return flusher, ok
+}
+
+// sseEvents 把预测/会话事件写为 SSE：结构化数据编码为 JSON 对象，文本增量编码为 JSON 字符串
+func sseEvents(w http.ResponseWriter, flusher http.Flusher) predictor.EventFunc {
+	return func(ev predictor.Event) error {
+		if ev.Data != nil {
+			return writeSSEJSON(w, flusher, ev.Type, ev.Data)
+		}
+		return writeSSE(w, flusher, ev.Type, ev.Text)
+	}
+}
+
 func writeSSE(w http.ResponseWriter, flusher http.Flusher, event, data string) error {
 	if event != "" {
 		if _, err := w.Write([]byte("event: " + event + "\n")); err != nil {
@@ -90,3 +311,16 @@ func writeSSE(w http.ResponseWriter, flusher http.Flusher, event, data string) e
 	flusher.Flush()
 	return nil
 }
+
+// writeSSEJSON 写入结构化 data（如技术指标、最终结果），以 JSON 对象而非字符串编码。
+func writeSSEJSON(w http.ResponseWriter, flusher http.Flusher, event string, v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte("event: " + event + "\ndata: " + string(payload) + "\n\n")); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+)
+
+// GetUsage GET /api/admin/usage?days=7，最近几天的 LLM token 用量与费用（按日、模型、入口汇总）及每日预算状态
+func GetUsage(ctx context.Context, c *app.RequestContext) {
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "7"))
+	rpcResp, err := rpc.AIClient.GetUsage(ctx, &ai.GetUsageRequest{Days: int32(days)})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	daysOut := make([]map[string]interface{}, 0, len(rpcResp.Days))
+	for _, d := range rpcResp.Days {
+		daysOut = append(daysOut, map[string]interface{}{
+			"date":        d.Date,
+			"total":       usageTotalsJSON(d.Total),
+			"by_model":    usageTotalsListJSON(d.ByModel),
+			"by_endpoint": usageTotalsListJSON(d.ByEndpoint),
+		})
+	}
+	var budget map[string]interface{}
+	if b := rpcResp.Budget; b != nil {
+		budget = map[string]interface{}{
+			"daily":          b.Daily,
+			"action":         b.Action,
+			"fallback_model": b.FallbackModel,
+			"today_cost":     b.TodayCost,
+			"exceeded":       b.Exceeded,
+			"currency":       b.Currency,
+		}
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"days":   daysOut,
+		"budget": budget,
+	})
+}
+
+func usageTotalsJSON(t *ai.UsageTotals) map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+	out := map[string]interface{}{
+		"calls":             t.Calls,
+		"prompt_tokens":     t.PromptTokens,
+		"completion_tokens": t.CompletionTokens,
+		"reasoning_tokens":  t.ReasoningTokens,
+		"total_tokens":      t.TotalTokens,
+		"cost":              t.Cost,
+		"estimated_calls":   t.EstimatedCalls,
+		"unpriced":          t.Unpriced,
+	}
+	if t.Key != "" {
+		out["key"] = t.Key
+	}
+	return out
+}
+
+func usageTotalsListJSON(list []*ai.UsageTotals) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(list))
+	for _, t := range list {
+		out = append(out, usageTotalsJSON(t))
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+const batchStreamURL = aiStreamBase + "/batch"
+
+// BatchPredictionBody request body for POST /api/prediction/batch
+type BatchPredictionBody struct {
+	Codes []string `json:"codes"` // 自选股代码列表
+	PredictionBody
+}
+
+// BatchPrediction POST /api/prediction/batch，对自选股逐只预测并汇总排序，流式返回 SSE（item 事件逐只推送，最后 digest）。
+func BatchPrediction(ctx context.Context, c *app.RequestContext) {
+	var body BatchPredictionBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
+	codes := make([]string, 0, len(body.Codes))
+	for _, code := range body.Codes {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, normalizeHKCode(code))
+		}
+	}
+	if len(codes) == 0 {
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingCode))
+		return
+	}
+	if !predictionDays(c, lang, &body.Days) {
+		return
+	}
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"codes":            codes,
+		"days":             body.Days,
+		"model":            body.Model,
+		"template_version": body.TemplateVersion,
+		"mode":             body.Mode,
+		"force_refresh":    body.ForceRefresh,
+		"language":         lang,
+	})
+	proxySSE(ctx, c, batchStreamURL, reqBody)
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+)
+
+// GetCalibration GET /api/prediction/calibration，各模型/模板版本的置信度校准曲线与可靠性图数据
+func GetCalibration(ctx context.Context, c *app.RequestContext) {
+	calibration(ctx, c, false)
+}
+
+// RefitCalibration POST /api/admin/calibration/refit，立即重新评估预测记录并拟合校准，返回新结果
+func RefitCalibration(ctx context.Context, c *app.RequestContext) {
+	calibration(ctx, c, true)
+}
+
+func calibration(ctx context.Context, c *app.RequestContext, refit bool) {
+	rpcResp, err := rpc.AIClient.GetCalibration(ctx, &ai.GetCalibrationRequest{Refit: refit})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	groups := make([]map[string]interface{}, 0, len(rpcResp.Groups))
+	for _, g := range rpcResp.Groups {
+		groups = append(groups, map[string]interface{}{
+			"model":            g.Model,
+			"template_version": g.TemplateVersion,
+			"samples":          g.Samples,
+			"rated":            g.Rated,
+			"base_rate":        g.BaseRate,
+			"brier_raw":        g.BrierRaw,
+			"brier_calibrated": g.BrierCalibrated,
+			"curve":            g.Curve,
+			"bins":             g.Bins,
+		})
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"fitted_at":   rpcResp.FittedAt,
+		"samples":     rpcResp.Samples,
+		"pending":     rpcResp.Pending,
+		"min_samples": rpcResp.MinSamples,
+		"groups":      groups,
+	})
+}
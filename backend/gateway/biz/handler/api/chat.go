@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+)
+
+const chatStreamURL = aiStreamBase + "/chat/messages"
+
+// ChatSessionBody request body for POST /api/chat/sessions
+type ChatSessionBody struct {
+	Code         string `json:"code"`
+	PredictionID string `json:"prediction_id"` // 以该次预测的数据与结论为背景；为空时按 code 现拉数据
+	Model        string `json:"model"`
+	Language     string `json:"language"` // 错误文案的语言，为空时按 Accept-Language
+}
+
+// CreateChatSession POST /api/chat/sessions
+func CreateChatSession(ctx context.Context, c *app.RequestContext) {
+	var body ChatSessionBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
+	code := strings.TrimSpace(body.Code)
+	if code != "" {
+		code = normalizeHKCode(code)
+	}
+	if code == "" && strings.TrimSpace(body.PredictionID) == "" {
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingChat))
+		return
+	}
+	rpcResp, err := rpc.AIClient.CreateChatSession(ctx, &ai.CreateChatSessionRequest{
+		Code:         code,
+		PredictionId: strings.TrimSpace(body.PredictionID),
+		Model:        body.Model,
+		Language:     &lang,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(consts.StatusOK, chatSessionJSON(rpcResp.Session))
+}
+
+// GetChatSession GET /api/chat/sessions/:id
+func GetChatSession(ctx context.Context, c *app.RequestContext) {
+	id := strings.TrimSpace(c.Param("id"))
+	rpcResp, err := rpc.AIClient.GetChatSession(ctx, &ai.GetChatSessionRequest{Id: id})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	if rpcResp.Session == nil {
+		c.String(consts.StatusNotFound, localized(requestLanguage(c, ""), msgNoSession))
+		return
+	}
+	c.JSON(consts.StatusOK, chatSessionJSON(rpcResp.Session))
+}
+
+// PostChatMessage POST /api/chat/sessions/:id/messages，body: {content, language}，流式返回 SSE。
+func PostChatMessage(ctx context.Context, c *app.RequestContext) {
+	var body struct {
+		Content  string `json:"content"`
+		Language string `json:"language"`
+	}
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
+	if strings.TrimSpace(body.Content) == "" {
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingText))
+		return
+	}
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"session_id": strings.TrimSpace(c.Param("id")),
+		"content":    body.Content,
+		"language":   lang,
+	})
+	proxySSE(ctx, c, chatStreamURL, reqBody)
+}
+
+func chatSessionJSON(s *ai.ChatSession) map[string]interface{} {
+	if s == nil {
+		return map[string]interface{}{}
+	}
+	messages := s.Messages
+	if messages == nil {
+		messages = []*ai.ChatMessage{}
+	}
+	return map[string]interface{}{
+		"id":            s.Id,
+		"code":          s.Code,
+		"prediction_id": s.PredictionId,
+		"model":         s.Model,
+		"messages":      messages,
+		"created_at":    s.CreatedAt,
+		"updated_at":    s.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+)
+
+// CompareBody request body for POST /api/prediction/compare
+type CompareBody struct {
+	Codes           []string `json:"codes"` // 2～5 只股票，如 hk00700、hk09988、hk03690
+	Days            int32    `json:"days"`
+	Model           string   `json:"model"`
+	TemplateVersion string   `json:"template_version"`
+	Language        string   `json:"language"`
+}
+
+// ComparePrediction POST /api/prediction/compare，并发采集多只股票的数据后由 LLM 横向对比并排名，各股附结构化结论；
+// 代码数量由 ai_service 校验
+func ComparePrediction(ctx context.Context, c *app.RequestContext) {
+	var body CompareBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
+	codes := make([]string, 0, len(body.Codes))
+	for _, code := range body.Codes {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, normalizeHKCode(code))
+		}
+	}
+	if !predictionDays(c, lang, &body.Days) {
+		return
+	}
+	rpcResp, err := rpc.AIClient.ComparePrediction(ctx, &ai.ComparePredictionRequest{
+		Codes:           codes,
+		Days:            body.Days,
+		Model:           body.Model,
+		TemplateVersion: body.TemplateVersion,
+		Language:        lang,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"days":             rpcResp.Days,
+		"model":            rpcResp.Model,
+		"template_version": rpcResp.TemplateVersion,
+		"language":         rpcResp.Language,
+		"analysis":         rpcResp.Analysis,
+		"stocks":           rpcResp.Stocks,
+		"warnings":         rpcResp.Warnings,
+	})
+}
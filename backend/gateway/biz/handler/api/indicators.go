@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+	"hk_stock_assistant/backend/stock_service/biz/indicator"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+const (
+	defaultIndicatorLimit = 120
+	maxIndicatorLimit     = 1000
+)
+
+// GetIndicators GET /api/stocks/:code/indicators?names=macd,rsi&period=day&limit=120
+// 在 stock_service 历史 K 线上计算技术指标，返回与 dates 对齐的序列（预热期为 null）。
+func GetIndicators(ctx context.Context, c *app.RequestContext) {
+	code := strings.TrimSpace(c.Param("code"))
+	if code == "" {
+		c.String(consts.StatusBadRequest, "missing code")
+		return
+	}
+	code = normalizeHKCode(code)
+	period := strings.TrimSpace(c.Query("period"))
+	if period == "" {
+		period = "day"
+	}
+	limit := defaultIndicatorLimit
+	if s := c.Query("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			c.String(consts.StatusBadRequest, "invalid limit")
+			return
+		}
+		limit = n
+	}
+	if limit > maxIndicatorLimit {
+		limit = maxIndicatorLimit
+	}
+	var names []string
+	if s := strings.TrimSpace(c.Query("names")); s != "" {
+		names = strings.Split(s, ",")
+	}
+
+	// 多取预热段，计算后再截断，保证返回区间内的均线等指标不为空
+	rpcResp, err := rpc.StockClient.GetKline(ctx, &stock.GetKlineRequest{
+		Code:   code,
+		Period: period,
+		Limit:  int32(limit + indicator.WarmupBars),
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	bars := indicator.FromKLines(rpcResp.Klines)
+	results, err := indicator.Compute(bars, names)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	if len(bars) > limit {
+		bars = bars[len(bars)-limit:]
+	}
+	dates := make([]string, 0, len(bars))
+	for _, b := range bars {
+		dates = append(dates, b.Date)
+	}
+	indicators := make(map[string]indicator.Result, len(results))
+	for name, r := range results {
+		indicators[name] = r.Tail(len(bars))
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"code":       code,
+		"period":     period,
+		"dates":      dates,
+		"indicators": indicators,
+	})
+}
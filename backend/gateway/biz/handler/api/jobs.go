@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+)
+
+const jobStreamURL = aiStreamBase + "/jobs/stream"
+
+// PredictionJobBody request body for POST /api/prediction/jobs
+type PredictionJobBody struct {
+	Code string `json:"code"`
+	PredictionBody
+}
+
+// SubmitPredictionJob POST /api/prediction/jobs，提交异步预测任务，立即返回任务 ID 与状态
+func SubmitPredictionJob(ctx context.Context, c *app.RequestContext) {
+	var body PredictionJobBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
+	code := strings.TrimSpace(body.Code)
+	if code == "" {
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingCode))
+		return
+	}
+	if !predictionDays(c, lang, &body.Days) {
+		return
+	}
+	rpcResp, err := rpc.AIClient.SubmitPredictionJob(ctx, &ai.SubmitPredictionJobRequest{
+		Prediction: &ai.GetPredictionRequest{
+			Code:            normalizeHKCode(code),
+			Days:            body.Days,
+			IncludeNews:     body.IncludeNews,
+			Model:           body.Model,
+			TemplateVersion: body.TemplateVersion,
+			Mode:            body.Mode,
+			ForceRefresh:    body.ForceRefresh,
+			Models:          body.Models,
+			Language:        lang,
+		},
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(consts.StatusAccepted, predictionJobJSON(rpcResp.Job))
+}
+
+// GetPredictionJob GET /api/prediction/jobs/:id，查询状态、进度，完成后含 result
+func GetPredictionJob(ctx context.Context, c *app.RequestContext) {
+	rpcResp, err := rpc.AIClient.GetPredictionJob(ctx, &ai.GetPredictionJobRequest{Id: strings.TrimSpace(c.Param("id"))})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	if rpcResp.Job == nil {
+		c.String(consts.StatusNotFound, localized(requestLanguage(c, ""), msgJobNotFound))
+		return
+	}
+	c.JSON(consts.StatusOK, predictionJobJSON(rpcResp.Job))
+}
+
+// CancelPredictionJob DELETE /api/prediction/jobs/:id，取消排队中或运行中的任务
+func CancelPredictionJob(ctx context.Context, c *app.RequestContext) {
+	rpcResp, err := rpc.AIClient.CancelPredictionJob(ctx, &ai.CancelPredictionJobRequest{Id: strings.TrimSpace(c.Param("id"))})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	if rpcResp.Job == nil {
+		c.String(consts.StatusNotFound, localized(requestLanguage(c, ""), msgJobNotFound))
+		return
+	}
+	c.JSON(consts.StatusOK, predictionJobJSON(rpcResp.Job))
+}
+
+// GetPredictionJobStream GET /api/prediction/jobs/:id/stream?language=，接入任务的 SSE 事件流（可断线重连，从头重放）；
+// 未指定 language 且无 Accept-Language 时错误文案按提交任务时的语言
+func GetPredictionJobStream(ctx context.Context, c *app.RequestContext) {
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"id":       strings.TrimSpace(c.Param("id")),
+		"language": requestLanguage(c, c.Query("language")),
+	})
+	proxySSE(ctx, c, jobStreamURL, reqBody)
+}
+
+func predictionJobJSON(j *ai.PredictionJob) map[string]interface{} {
+	if j == nil {
+		return map[string]interface{}{}
+	}
+	out := map[string]interface{}{
+		"id":             j.Id,
+		"status":         j.Status,
+		"stage":          j.Stage,
+		"progress":       j.Progress,
+		"code":           j.Code,
+		"created_at":     j.CreatedAt,
+		"started_at":     j.StartedAt,
+		"finished_at":    j.FinishedAt,
+		"error":          j.Error,
+		"queue_position": j.QueuePosition,
+	}
+	if j.Result_ != nil {
+		out["result"] = predictionResultJSON(j.Result_)
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// 预测输出语言，与 ai_service 的 i18n 一致
+const (
+	langZhCN = "zh-CN"
+	langZhTW = "zh-TW"
+	langEn   = "en"
+)
+
+// requestLanguage 预测输出语言：请求体 language 非空时原样透传（由 ai_service 校验），
+// 否则取 Accept-Language 中第一个可识别的语言（港台地区为繁体），都没有时为空（ai_service 按简体中文）。
+func requestLanguage(c *app.RequestContext, language string) string {
+	if s := strings.TrimSpace(language); s != "" {
+		return s
+	}
+	for _, part := range strings.Split(string(c.GetHeader("Accept-Language")), ",") {
+		if lang := matchLanguage(part); lang != "" {
+			return lang
+		}
+	}
+	return ""
+}
+
+// matchLanguage 将 zh-HK、zh-Hant-TW、en-US;q=0.8 等写法映射为支持的语言，无法识别时为空
+func matchLanguage(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if i := strings.Index(tag, ";"); i >= 0 {
+		tag = tag[:i]
+	}
+	s := strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	switch {
+	case s == "zh-tw" || s == "zh-hk" || s == "zh-mo" || strings.HasPrefix(s, "zh-hant"):
+		return langZhTW
+	case s == "zh" || strings.HasPrefix(s, "zh-"):
+		return langZhCN
+	case s == "en" || strings.HasPrefix(s, "en-"):
+		return langEn
+	}
+	return ""
+}
+
+// 网关自身的错误文案
+const (
+	msgMissingCode = "missing_code"
+	msgEmptyResult = "empty_result"
+	msgJobNotFound = "job_not_found"
+	msgMissingChat = "missing_chat_source"
+	msgMissingText = "missing_content"
+	msgNoSession   = "session_not_found"
+	msgDaysRange   = "days_out_of_range"
+)
+
+var gatewayMessages = map[string]map[string]string{
+	msgMissingCode: {langZhCN: "缺少股票代码", langZhTW: "缺少股票代號", langEn: "missing code"},
+	msgEmptyResult: {langZhCN: "AI 服务返回了空结果", langZhTW: "AI 服務回傳了空結果", langEn: "AI service returned empty result"},
+	msgJobNotFound: {langZhCN: "任务不存在", langZhTW: "任務不存在", langEn: "job not found"},
+	msgMissingChat: {langZhCN: "缺少股票代码或预测 ID", langZhTW: "缺少股票代號或預測 ID", langEn: "missing code or prediction_id"},
+	msgMissingText: {langZhCN: "缺少消息内容", langZhTW: "缺少訊息內容", langEn: "missing content"},
+	msgNoSession:   {langZhCN: "会话不存在", langZhTW: "對話不存在", langEn: "session not found"},
+	msgDaysRange:   {langZhCN: "预测周期须为 1～%d 个交易日", langZhTW: "預測週期須為 1～%d 個交易日", langEn: "days must be between 1 and %d trading days"},
+}
+
+// localized 网关错误文案：lang 为空或无法识别时用英文（与原有响应一致）
+func localized(lang, key string) string {
+	m := gatewayMessages[key]
+	if s, ok := m[matchLanguage(lang)]; ok {
+		return s
+	}
+	return m[langEn]
+}
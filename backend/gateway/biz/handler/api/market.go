@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
+	"hk_stock_assistant/backend/gateway/biz/rpc"
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+const commentaryStreamURL = aiStreamBase + "/market/commentary"
+
+// GetMarketOverview GET /api/market/overview?top_n=，大盘指数、涨跌家数、涨跌幅与主力资金排行、港股通南向资金；
+// 单项获取失败时其余部分照常返回，原因见 warnings
+func GetMarketOverview(ctx context.Context, c *app.RequestContext) {
+	topN, _ := strconv.Atoi(c.Query("top_n"))
+	rpcResp, err := rpc.StockClient.GetMarketOverview(ctx, &stock.GetMarketOverviewRequest{TopN: int32(topN)})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"indices":         rpcResp.Indices,
+		"breadth":         rpcResp.Breadth,
+		"gainers":         rpcResp.Gainers,
+		"losers":          rpcResp.Losers,
+		"inflow_leaders":  rpcResp.InflowLeaders,
+		"outflow_leaders": rpcResp.OutflowLeaders,
+		"southbound":      rpcResp.Southbound,
+		"timestamp":       rpcResp.Timestamp,
+		"warnings":        rpcResp.Warnings,
+	})
+}
+
+// GetMarketCommentary GET /api/market/commentary?language=，当前市场时段的 AI 大市点评；
+// 同一时段（开盘前/早市/午休/午市/收市后）只生成一次，之后的访客直接取缓存（重新生成见 RefreshMarketCommentary）
+func GetMarketCommentary(ctx context.Context, c *app.RequestContext) {
+	marketCommentary(ctx, c, false)
+}
+
+// RefreshMarketCommentary POST /api/admin/market/commentary/refresh?language=，跳过缓存重新生成当前时段的点评
+func RefreshMarketCommentary(ctx context.Context, c *app.RequestContext) {
+	marketCommentary(ctx, c, true)
+}
+
+func marketCommentary(ctx context.Context, c *app.RequestContext, force bool) {
+	lang := requestLanguage(c, c.Query("language"))
+	rpcResp, err := rpc.AIClient.GetMarketCommentary(ctx, &ai.GetMarketCommentaryRequest{Language: lang, ForceRefresh: force})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	m := rpcResp.Commentary
+	if m == nil {
+		c.String(consts.StatusInternalServerError, localized(lang, msgEmptyResult))
+		return
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{
+		"session_date":     m.SessionDate,
+		"phase":            m.Phase,
+		"commentary":       m.Commentary,
+		"model":            m.Model,
+		"template_version": m.TemplateVersion,
+		"language":         m.Language,
+		"generated_at":     m.GeneratedAt,
+		"expires_at":       m.ExpiresAt,
+		"cached":           m.Cached,
+		"data_warnings":    m.DataWarnings,
+	})
+}
+
+// GetMarketCommentaryStream GET /api/market/commentary/stream?language=，SSE 代理到 ai_service；
+// 事件：overview、reasoning、content、commentary、done、error
+func GetMarketCommentaryStream(ctx context.Context, c *app.RequestContext) {
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"language": requestLanguage(c, c.Query("language")),
+	})
+	proxySSE(ctx, c, commentaryStreamURL, reqBody)
+}
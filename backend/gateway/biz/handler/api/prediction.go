@@ -1,49 +1,71 @@
 package api
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"io"
-	"net/http"
+	"fmt"
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
-	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
 	"hk_stock_assistant/backend/ai_service/kitex_gen/ai"
 	"hk_stock_assistant/backend/gateway/biz/rpc"
 )
 
-const streamBackendURL = "http://127.0.0.1:8890/stream"
+const streamBackendURL = aiStreamBase + "/stream"
+
+// maxDays 预测周期上限，与 ai_service 的 quant.MaxDays 一致
+const maxDays = 60
+
+// predictionDays 补全预测周期默认值（3 天）；超过上限时返回 400 并返回 false
+func predictionDays(c *app.RequestContext, lang string, days *int32) bool {
+	if *days > maxDays {
+		c.String(consts.StatusBadRequest, fmt.Sprintf(localized(lang, msgDaysRange), maxDays))
+		return false
+	}
+	if *days <= 0 {
+		*days = 3
+	}
+	return true
+}
 
 // PredictionBody request body for POST /api/prediction/:code
 type PredictionBody struct {
-	Days        int32  `json:"days"`
-	IncludeNews bool   `json:"include_news"`
-	Model       string `json:"model"`
+	Days            int32    `json:"days"`
+	IncludeNews     bool     `json:"include_news"`
+	Model           string   `json:"model"`
+	TemplateVersion string   `json:"template_version"` // prompt 模板版本，为空时由 ai_service 按权重选择
+	Mode            string   `json:"mode"`             // single（默认）或 debate（多空辩论）
+	ForceRefresh    bool     `json:"force_refresh"`    // 跳过结果缓存
+	Models          []string `json:"models"`           // 对比的多个模型（2～5 个，可含 quant），返回各模型结果与加权投票
+	Language        string   `json:"language"`         // 输出语言 zh-CN/zh-TW/en，为空时按 Accept-Language
 }
 
 // GetPrediction POST /api/prediction/:code
 func GetPrediction(ctx context.Context, c *app.RequestContext) {
+	var body PredictionBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
 	code := strings.TrimSpace(c.Param("code"))
 	if code == "" {
-		c.String(consts.StatusBadRequest, "missing code")
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingCode))
 		return
 	}
 	code = normalizeHKCode(code)
-
-	var body PredictionBody
-	_ = c.BindJSON(&body)
-	if body.Days <= 0 {
-		body.Days = 3
+	if !predictionDays(c, lang, &body.Days) {
+		return
 	}
 
 	rpcReq := &ai.GetPredictionRequest{
-		Code:        code,
-		Days:        body.Days,
-		IncludeNews: body.IncludeNews,
-		Model:       body.Model,
+		Code:            code,
+		Days:            body.Days,
+		IncludeNews:     body.IncludeNews,
+		Model:           body.Model,
+		TemplateVersion: body.TemplateVersion,
+		Mode:            body.Mode,
+		ForceRefresh:    body.ForceRefresh,
+		Models:          body.Models,
+		Language:        lang,
 	}
 	rpcResp, err := rpc.AIClient.GetPrediction(ctx, rpcReq)
 	if err != nil {
@@ -51,69 +73,81 @@ func GetPrediction(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	if rpcResp.Result_ == nil {
-		c.String(consts.StatusInternalServerError, "AI service returned empty result")
+		c.String(consts.StatusInternalServerError, localized(lang, msgEmptyResult))
 		return
 	}
-	c.JSON(consts.StatusOK, map[string]interface{}{
-		"code":         rpcResp.Result_.Code,
-		"confidence":   rpcResp.Result_.Confidence,
-		"analysis":     rpcResp.Result_.Analysis,
-		"news_summary": rpcResp.Result_.NewsSummary_,
-	})
+	c.JSON(consts.StatusOK, predictionResultJSON(rpcResp.Result_))
+}
+
+func predictionResultJSON(r *ai.PredictionResult_) map[string]interface{} {
+	return map[string]interface{}{
+		"code":             r.Code,
+		"confidence":       r.Confidence,
+		"raw_confidence":   r.RawConfidence,
+		"calibrated":       r.Calibrated,
+		"analysis":         r.Analysis,
+		"news_summary":     r.NewsSummary_,
+		"technical":        r.Technical,
+		"verdict":          r.Verdict,
+		"model":            r.Model,
+		"template_version": r.TemplateVersion,
+		"prediction_id":    r.PredictionId,
+		"tool_calls":       r.ToolCalls,
+		"mode":             r.Mode,
+		"debate":           r.Debate,
+		"cached":           r.Cached,
+		"bands":            r.Bands,
+		"ensemble":         ensembleJSON(r.Ensemble),
+		"warnings":         r.Warnings,
+		"language":         r.Language,
+		"context":          r.Context,
+	}
+}
+
+func ensembleJSON(e *ai.Ensemble) map[string]interface{} {
+	if e == nil {
+		return nil
+	}
+	members := make([]map[string]interface{}, 0, len(e.Members))
+	for _, m := range e.Members {
+		member := map[string]interface{}{
+			"model":    m.Model,
+			"weight":   m.Weight,
+			"accuracy": m.Accuracy,
+			"samples":  m.Samples,
+			"error":    m.Error,
+		}
+		if m.Result_ != nil {
+			member["result"] = predictionResultJSON(m.Result_)
+		}
+		members = append(members, member)
+	}
+	return map[string]interface{}{"members": members, "votes": e.Votes, "agreement": e.Agreement}
 }
 
 // GetPredictionStream POST /api/prediction/:code/stream，流式返回 SSE。
 func GetPredictionStream(ctx context.Context, c *app.RequestContext) {
+	var body PredictionBody
+	_ = c.BindJSON(&body)
+	lang := requestLanguage(c, body.Language)
 	code := strings.TrimSpace(c.Param("code"))
 	if code == "" {
-		c.String(consts.StatusBadRequest, "missing code")
+		c.String(consts.StatusBadRequest, localized(lang, msgMissingCode))
 		return
 	}
 	code = normalizeHKCode(code)
-	var body PredictionBody
-	_ = c.BindJSON(&body)
-	if body.Days <= 0 {
-		body.Days = 3
+	if !predictionDays(c, lang, &body.Days) {
+		return
 	}
 	reqBody, _ := json.Marshal(map[string]interface{}{
-		"code":  code,
-		"days":  body.Days,
-		"model": body.Model,
+		"code":             code,
+		"days":             body.Days,
+		"model":            body.Model,
+		"template_version": body.TemplateVersion,
+		"mode":             body.Mode,
+		"force_refresh":    body.ForceRefresh,
+		"models":           body.Models,
+		"language":         lang,
 	})
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, streamBackendURL, bytes.NewReader(reqBody))
-	if err != nil {
-		c.String(consts.StatusInternalServerError, err.Error())
-		return
-	}
-	req.Header.Set("Content-Type", "application/json")
-	backendResp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		c.String(consts.StatusInternalServerError, err.Error())
-		return
-	}
-	defer backendResp.Body.Close()
-	if backendResp.StatusCode != http.StatusOK {
-		bs, _ := io.ReadAll(backendResp.Body)
-		c.String(backendResp.StatusCode, string(bs))
-		return
-	}
-	c.Response.Header.Set("Content-Type", "text/event-stream")
-	c.Response.Header.Set("Cache-Control", "no-cache")
-	c.Response.Header.Set("Connection", "keep-alive")
-	c.Response.Header.Set("X-Accel-Buffering", "no")
-	c.Response.HijackWriter(resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
-	buf := make([]byte, 4096)
-	for {
-		n, err := backendResp.Body.Read(buf)
-		if n > 0 {
-			_, _ = c.Write(buf[:n])
-			_ = c.Flush()
-		}
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			break
-		}
-	}
+	proxySSE(ctx, c, streamBackendURL, reqBody)
 }
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+)
+
+// aiStreamBase ai_service HTTP 流式服务地址
+const aiStreamBase = "http://127.0.0.1:8890"
+
+// sseKeepalive 后端无输出（如排队、模型思考）时向客户端发送 SSE 注释的间隔，用于及时发现客户端断开
+const sseKeepalive = 10 * time.Second
+
+// proxySSE 以 JSON body POST 到 ai_service 流式接口，并把 SSE 响应原样转发给客户端；后端非 200 时转发状态码与错误信息。
+// 写客户端失败（客户端已断开）时立即取消到后端的请求，ai_service 随之取消 LLM 调用。
+func proxySSE(ctx context.Context, c *app.RequestContext, url string, reqBody []byte) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	backendResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	defer backendResp.Body.Close()
+	if backendResp.StatusCode != http.StatusOK {
+		bs, _ := io.ReadAll(backendResp.Body)
+		c.String(backendResp.StatusCode, string(bs))
+		return
+	}
+	c.Response.Header.Set("Content-Type", "text/event-stream")
+	c.Response.Header.Set("Cache-Control", "no-cache")
+	c.Response.Header.Set("Connection", "keep-alive")
+	c.Response.Header.Set("X-Accel-Buffering", "no")
+	c.Response.HijackWriter(resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
+
+	chunks := make(chan []byte)
+	go func() {
+		defer close(chunks)
+		buf := make([]byte, 4096)
+		for {
+			n, err := backendResp.Body.Read(buf)
+			if n > 0 {
+				select {
+				case chunks <- append([]byte(nil), buf[:n]...):
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	keepalive := time.NewTicker(sseKeepalive)
+	defer keepalive.Stop()
+	for {
+		var data []byte
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				return
+			}
+			data = chunk
+		case <-keepalive.C:
+			data = []byte(": keepalive\n\n")
+		}
+		if _, err := c.Write(data); err == nil {
+			err = c.Flush()
+			if err == nil {
+				continue
+			}
+		}
+		log.Printf("[stream] client disconnected, cancel %s", url)
+		return
+	}
+}
@@ -15,8 +15,25 @@ func register(r *server.Hertz) {
 	})
 	apiGroup := r.Group("/api")
 	apiGroup.GET("/stocks/:code/realtime", api.GetRealtime)
+	apiGroup.GET("/stocks/:code/indicators", api.GetIndicators)
 	apiGroup.GET("/market/summary", api.GetMarketSummary)
 	apiGroup.GET("/market/sectors", api.GetSectors)
+	apiGroup.GET("/market/overview", api.GetMarketOverview)
+	apiGroup.GET("/market/commentary", api.GetMarketCommentary)
+	apiGroup.GET("/market/commentary/stream", api.GetMarketCommentaryStream)
 	apiGroup.POST("/prediction/:code", api.GetPrediction)
 	apiGroup.POST("/prediction/:code/stream", api.GetPredictionStream)
+	apiGroup.POST("/prediction/batch", api.BatchPrediction)
+	apiGroup.POST("/prediction/compare", api.ComparePrediction)
+	apiGroup.GET("/prediction/calibration", api.GetCalibration)
+	apiGroup.POST("/prediction/jobs", api.SubmitPredictionJob)
+	apiGroup.GET("/prediction/jobs/:id", api.GetPredictionJob)
+	apiGroup.DELETE("/prediction/jobs/:id", api.CancelPredictionJob)
+	apiGroup.GET("/prediction/jobs/:id/stream", api.GetPredictionJobStream)
+	apiGroup.POST("/chat/sessions", api.CreateChatSession)
+	apiGroup.GET("/chat/sessions/:id", api.GetChatSession)
+	apiGroup.POST("/chat/sessions/:id/messages", api.PostChatMessage)
+	apiGroup.GET("/admin/usage", api.GetUsage)
+	apiGroup.POST("/admin/calibration/refit", api.RefitCalibration)
+	apiGroup.POST("/admin/market/commentary/refresh", api.RefreshMarketCommentary)
 }
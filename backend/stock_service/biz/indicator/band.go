@@ -0,0 +1,62 @@
+package indicator
+
+import "math"
+
+// BOLLValue 布林带单点值
+type BOLLValue struct {
+	Upper float64
+	Mid   float64
+	Lower float64
+}
+
+// BOLL 布林带，默认参数 (20, 2)；中轨 MA(N)，上下轨 ±K 倍总体标准差
+type BOLL struct {
+	w *window
+	k float64
+}
+
+// NewBOLL 创建 BOLL(period, k)
+func NewBOLL(period int, k float64) *BOLL {
+	return &BOLL{w: newWindow(period), k: k}
+}
+
+// Update 输入收盘价；不足 period 根时为 NaN
+func (b *BOLL) Update(close float64) BOLLValue {
+	b.w.push(close)
+	if !b.Ready() {
+		return BOLLValue{Upper: math.NaN(), Mid: math.NaN(), Lower: math.NaN()}
+	}
+	n := float64(len(b.w.buf))
+	mid := b.w.sum / n
+	var sq float64
+	for _, v := range b.w.buf {
+		sq += (v - mid) * (v - mid)
+	}
+	std := math.Sqrt(sq / n)
+	return BOLLValue{Upper: mid + b.k*std, Mid: mid, Lower: mid - b.k*std}
+}
+
+// Ready 是否已累计满 period 根
+func (b *BOLL) Ready() bool { return b.w.full }
+
+// ATR 平均真实波幅 MA(TR, N)，默认 N=14
+type ATR struct {
+	ma        *SMA
+	prevClose float64
+	hasPrev   bool
+}
+
+// NewATR 创建 period 周期 ATR
+func NewATR(period int) *ATR {
+	return &ATR{ma: NewSMA(period)}
+}
+
+// Update 输入 K 线；不足 period 根时为 NaN
+func (a *ATR) Update(b Bar) float64 {
+	tr := trueRange(b, a.prevClose, a.hasPrev)
+	a.prevClose, a.hasPrev = b.Close, true
+	return a.ma.Update(tr)
+}
+
+// Ready 是否已累计满 period 根
+func (a *ATR) Ready() bool { return a.ma.Ready() }
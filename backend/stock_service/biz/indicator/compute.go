@@ -0,0 +1,158 @@
+package indicator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Result 单个指标的各分量序列，如 macd -> {dif, dea, hist}
+type Result map[string]Series
+
+// computeFunc 在整段 K 线上运行一个指标
+type computeFunc func(bars []Bar) Result
+
+// registry 指标名 -> 默认参数下的批量计算
+var registry = map[string]computeFunc{
+	"ma": func(bars []Bar) Result {
+		out := Result{}
+		for _, n := range []int{5, 10, 20, 60} {
+			ma := NewSMA(n)
+			s := make(Series, len(bars))
+			for i, b := range bars {
+				s[i] = ma.Update(b.Close)
+			}
+			out[fmt.Sprintf("ma%d", n)] = s
+		}
+		return out
+	},
+	"ema": func(bars []Bar) Result {
+		out := Result{}
+		for _, n := range []int{12, 26} {
+			ema := NewEMA(n)
+			s := make(Series, len(bars))
+			for i, b := range bars {
+				s[i] = ema.Update(b.Close)
+			}
+			out[fmt.Sprintf("ema%d", n)] = s
+		}
+		return out
+	},
+	"macd": func(bars []Bar) Result {
+		m := NewMACD(12, 26, 9)
+		dif, dea, hist := make(Series, len(bars)), make(Series, len(bars)), make(Series, len(bars))
+		for i, b := range bars {
+			v := m.Update(b.Close)
+			dif[i], dea[i], hist[i] = v.DIF, v.DEA, v.Hist
+		}
+		return Result{"dif": dif, "dea": dea, "hist": hist}
+	},
+	"rsi": func(bars []Bar) Result {
+		out := Result{}
+		for _, n := range []int{6, 12, 24} {
+			r := NewRSI(n)
+			s := make(Series, len(bars))
+			for i, b := range bars {
+				s[i] = r.Update(b.Close)
+			}
+			out[fmt.Sprintf("rsi%d", n)] = s
+		}
+		return out
+	},
+	"kdj": func(bars []Bar) Result {
+		k := NewKDJ(9, 3, 3)
+		ks, ds, js := make(Series, len(bars)), make(Series, len(bars)), make(Series, len(bars))
+		for i, b := range bars {
+			v := k.Update(b)
+			ks[i], ds[i], js[i] = v.K, v.D, v.J
+		}
+		return Result{"k": ks, "d": ds, "j": js}
+	},
+	"boll": func(bars []Bar) Result {
+		bb := NewBOLL(20, 2)
+		up, mid, low := make(Series, len(bars)), make(Series, len(bars)), make(Series, len(bars))
+		for i, b := range bars {
+			v := bb.Update(b.Close)
+			up[i], mid[i], low[i] = v.Upper, v.Mid, v.Lower
+		}
+		return Result{"upper": up, "mid": mid, "lower": low}
+	},
+	"atr": func(bars []Bar) Result {
+		a := NewATR(14)
+		s := make(Series, len(bars))
+		for i, b := range bars {
+			s[i] = a.Update(b)
+		}
+		return Result{"atr14": s}
+	},
+	"obv": func(bars []Bar) Result {
+		o := NewOBV()
+		s := make(Series, len(bars))
+		for i, b := range bars {
+			s[i] = o.Update(b)
+		}
+		return Result{"obv": s}
+	},
+	"vwap": func(bars []Bar) Result {
+		v := NewVWAP()
+		s := make(Series, len(bars))
+		for i, b := range bars {
+			s[i] = v.Update(b)
+		}
+		return Result{"vwap": s}
+	},
+	"dmi": func(bars []Bar) Result {
+		d := NewDMI(14, 6)
+		pdi, mdi, adx, adxr := make(Series, len(bars)), make(Series, len(bars)), make(Series, len(bars)), make(Series, len(bars))
+		for i, b := range bars {
+			v := d.Update(b)
+			pdi[i], mdi[i], adx[i], adxr[i] = v.PDI, v.MDI, v.ADX, v.ADXR
+		}
+		return Result{"pdi": pdi, "mdi": mdi, "adx": adx, "adxr": adxr}
+	},
+	"vol_ratio": func(bars []Bar) Result {
+		r := NewVolumeRatio(5)
+		s := make(Series, len(bars))
+		for i, b := range bars {
+			s[i] = r.Update(b)
+		}
+		return Result{"vol_ratio": s}
+	},
+}
+
+// Names 支持的指标名（按文档顺序）
+var Names = []string{"ma", "ema", "macd", "rsi", "kdj", "boll", "atr", "obv", "vwap", "dmi", "vol_ratio"}
+
+// WarmupBars 默认参数下预热最长的指标（MA60）所需根数，调用方可多取这么多根再截断
+const WarmupBars = 60
+
+// Compute 在整段 K 线上按默认参数计算指定指标；names 为空时计算全部，名称大小写不敏感。
+func Compute(bars []Bar, names []string) (map[string]Result, error) {
+	if len(names) == 0 {
+		names = Names
+	}
+	out := make(map[string]Result, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		fn, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown indicator: %s (supported: %s)", name, strings.Join(Names, ","))
+		}
+		out[name] = fn(bars)
+	}
+	return out, nil
+}
+
+// Tail 截取每个序列的最后 n 个值，用于去掉预热段
+func (r Result) Tail(n int) Result {
+	out := make(Result, len(r))
+	for k, s := range r {
+		if n < len(s) {
+			s = s[len(s)-n:]
+		}
+		out[k] = s
+	}
+	return out
+}
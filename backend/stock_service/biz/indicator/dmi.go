@@ -0,0 +1,77 @@
+package indicator
+
+import "math"
+
+// DMIValue 趋向指标单点值
+type DMIValue struct {
+	PDI  float64
+	MDI  float64
+	ADX  float64
+	ADXR float64
+}
+
+// DMI 趋向指标，默认参数 (14, 6)（通达信口径）：
+// TR、+DM、-DM 取 N 日累计，PDI/MDI = DM*100/TR，ADX = MA(|MDI-PDI|/(MDI+PDI)*100, M)，ADXR = (ADX + M 日前 ADX)/2
+type DMI struct {
+	tr, pdm, mdm *window
+	adx          *SMA
+	adxHist      *window
+	prev         Bar
+	count        int
+}
+
+// NewDMI 创建 DMI(n, m)
+func NewDMI(n, m int) *DMI {
+	return &DMI{
+		tr:      newWindow(n),
+		pdm:     newWindow(n),
+		mdm:     newWindow(n),
+		adx:     NewSMA(m),
+		adxHist: newWindow(m + 1),
+	}
+}
+
+// Update 输入 K 线；PDI/MDI 需 N+1 根，ADX 再需 M 根，ADXR 再需 M 根
+func (d *DMI) Update(b Bar) DMIValue {
+	out := DMIValue{PDI: math.NaN(), MDI: math.NaN(), ADX: math.NaN(), ADXR: math.NaN()}
+	d.count++
+	if d.count == 1 {
+		d.prev = b
+		return out
+	}
+	hd := b.High - d.prev.High
+	ld := d.prev.Low - b.Low
+	pdm, mdm := 0.0, 0.0
+	if hd > 0 && hd > ld {
+		pdm = hd
+	}
+	if ld > 0 && ld > hd {
+		mdm = ld
+	}
+	d.tr.push(trueRange(b, d.prev.Close, true))
+	d.pdm.push(pdm)
+	d.mdm.push(mdm)
+	d.prev = b
+	if !d.tr.full || d.tr.sum == 0 {
+		return out
+	}
+	out.PDI = d.pdm.sum * 100 / d.tr.sum
+	out.MDI = d.mdm.sum * 100 / d.tr.sum
+	dx := 0.0
+	if out.PDI+out.MDI > 0 {
+		dx = math.Abs(out.MDI-out.PDI) / (out.MDI + out.PDI) * 100
+	}
+	out.ADX = d.adx.Update(dx)
+	if math.IsNaN(out.ADX) {
+		return out
+	}
+	// adxHist 保存最近 M+1 个 ADX，满后最旧的一个即 M 日前的 ADX
+	d.adxHist.push(out.ADX)
+	if d.adxHist.full {
+		out.ADXR = (out.ADX + d.adxHist.buf[d.adxHist.pos]) / 2
+	}
+	return out
+}
+
+// Ready 是否已有 PDI/MDI 输出
+func (d *DMI) Ready() bool { return d.tr.full }
@@ -0,0 +1,136 @@
+// Package indicator 增量式技术指标，参数与口径对齐通达信/同花顺（A 股、港股通用）。
+//
+// 每个指标都是一个带状态的计算器：逐根 K 线调用 Update，返回该根 K 线上的指标值；
+// 样本不足（预热期）时返回 NaN，Ready 报告是否已产出有效值。
+// 批量计算请使用 Compute，它按名称在整段 K 线上运行计算器并返回对齐的序列。
+package indicator
+
+import (
+	"math"
+	"strconv"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Bar 单根 K 线
+type Bar struct {
+	Date   string
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+	Amount float64
+}
+
+// FromKLines 将 stock_service 返回的 K 线转为 Bar 序列（保持原顺序，应为日期升序）
+func FromKLines(klines []*stock.KLine) []Bar {
+	bars := make([]Bar, 0, len(klines))
+	for _, k := range klines {
+		if k == nil {
+			continue
+		}
+		bars = append(bars, Bar{
+			Date:   k.Date,
+			Open:   k.Open,
+			High:   k.High,
+			Low:    k.Low,
+			Close:  k.Close,
+			Volume: float64(k.Volume),
+			Amount: k.Amount,
+		})
+	}
+	return bars
+}
+
+// Series 与 K 线对齐的指标序列，预热期为 NaN；JSON 编码时 NaN 输出为 null。
+type Series []float64
+
+// Last 返回最后一个值，空序列返回 NaN
+func (s Series) Last() float64 {
+	if len(s) == 0 {
+		return math.NaN()
+	}
+	return s[len(s)-1]
+}
+
+// MarshalJSON 将 NaN/Inf 编码为 null，保留 4 位小数
+func (s Series) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, len(s)*8+2)
+	buf = append(buf, '[')
+	for i, v := range s {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			buf = append(buf, "null"...)
+			continue
+		}
+		buf = strconv.AppendFloat(buf, v, 'f', 4, 64)
+	}
+	buf = append(buf, ']')
+	return buf, nil
+}
+
+// window 固定长度滑动窗口，维护窗口内的和
+type window struct {
+	buf  []float64
+	pos  int
+	full bool
+	sum  float64
+}
+
+func newWindow(size int) *window {
+	if size < 1 {
+		size = 1
+	}
+	return &window{buf: make([]float64, size)}
+}
+
+// push 放入新值，窗口满后挤出最旧的值
+func (w *window) push(v float64) {
+	if w.full {
+		w.sum -= w.buf[w.pos]
+	}
+	w.buf[w.pos] = v
+	w.sum += v
+	w.pos++
+	if w.pos == len(w.buf) {
+		w.pos = 0
+		w.full = true
+	}
+}
+
+// values 窗口内已有的值（不保证顺序）
+func (w *window) values() []float64 {
+	if w.full {
+		return w.buf
+	}
+	return w.buf[:w.pos]
+}
+
+func (w *window) max() float64 {
+	m := math.Inf(-1)
+	for _, v := range w.values() {
+		m = math.Max(m, v)
+	}
+	return m
+}
+
+func (w *window) min() float64 {
+	m := math.Inf(1)
+	for _, v := range w.values() {
+		m = math.Min(m, v)
+	}
+	return m
+}
+
+// trueRange 真实波幅；无前收时为当日振幅
+func trueRange(b Bar, prevClose float64, hasPrev bool) float64 {
+	tr := b.High - b.Low
+	if hasPrev {
+		tr = math.Max(tr, math.Abs(b.High-prevClose))
+		tr = math.Max(tr, math.Abs(b.Low-prevClose))
+	}
+	return tr
+}
@@ -0,0 +1,311 @@
+package indicator
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// 参考值由独立脚本按通达信公式逐根计算
+var (
+	nan = math.NaN()
+
+	testCloses = []float64{10, 11, 12, 11, 13, 14, 13, 15}
+	testHighs  = []float64{11, 12, 13, 12.5, 14, 15, 14, 16}
+	testLows   = []float64{9, 10, 11, 10, 12, 12, 12, 14}
+	testVols   = []float64{100, 200, 150, 300, 250, 0, 400, 350}
+)
+
+func testBars() []Bar {
+	bars := make([]Bar, len(testCloses))
+	for i := range bars {
+		bars[i] = Bar{Close: testCloses[i], High: testHighs[i], Low: testLows[i], Open: testCloses[i], Volume: testVols[i]}
+	}
+	return bars
+}
+
+// assertSeries 逐点比较，want 为 NaN 的位置 got 也须为 NaN（预热期）
+func assertSeries(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: len = %d, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if math.IsNaN(want[i]) {
+			if !math.IsNaN(got[i]) {
+				t.Errorf("%s[%d] = %v, want NaN (warm-up)", name, i, got[i])
+			}
+			continue
+		}
+		if math.IsNaN(got[i]) || math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("%s[%d] = %v, want %v", name, i, got[i], want[i])
+		}
+	}
+}
+
+// run 逐根调用 update 收集输出
+func run(n int, update func(i int) []float64) [][]float64 {
+	var out [][]float64
+	for i := 0; i < n; i++ {
+		vals := update(i)
+		if out == nil {
+			out = make([][]float64, len(vals))
+		}
+		for j, v := range vals {
+			out[j] = append(out[j], v)
+		}
+	}
+	return out
+}
+
+func TestIndicators(t *testing.T) {
+	bars := testBars()
+	tests := []struct {
+		name   string
+		update func() func(i int) []float64
+		want   map[string][]float64
+		order  []string
+	}{
+		{
+			name: "SMA(3)",
+			update: func() func(int) []float64 {
+				s := NewSMA(3)
+				return func(i int) []float64 { return []float64{s.Update(testCloses[i])} }
+			},
+			order: []string{"ma"},
+			want:  map[string][]float64{"ma": {nan, nan, 11, 34.0 / 3, 12, 38.0 / 3, 40.0 / 3, 14}},
+		},
+		{
+			name: "EMA(3)",
+			update: func() func(int) []float64 {
+				e := NewEMA(3)
+				return func(i int) []float64 { return []float64{e.Update(testCloses[i])} }
+			},
+			order: []string{"ema"},
+			want:  map[string][]float64{"ema": {10, 10.5, 11.25, 11.125, 12.0625, 13.03125, 13.015625, 14.0078125}},
+		},
+		{
+			name: "MACD(2,3,2)",
+			update: func() func(int) []float64 {
+				m := NewMACD(2, 3, 2)
+				return func(i int) []float64 {
+					v := m.Update(testCloses[i])
+					return []float64{v.DIF, v.DEA, v.Hist}
+				}
+			},
+			order: []string{"dif", "dea", "hist"},
+			want: map[string][]float64{
+				"dif":  {nan, nan, 0.30555555555555713, 0.060185185185186896, 0.3325617283950617, 0.43377057613168546, 0.13938185871056064, 0.37718978623685473},
+				"dea":  {nan, nan, 0.24074074074074206, 0.12037037037037196, 0.2618312757201651, 0.376457475994512, 0.21840706447187777, 0.3242622123151957},
+				"hist": {nan, nan, 0.12962962962963015, -0.12037037037037013, 0.14146090534979316, 0.11462620027434689, -0.15805041152263427, 0.10585514784331806},
+			},
+		},
+		{
+			name: "RSI(3)",
+			update: func() func(int) []float64 {
+				r := NewRSI(3)
+				return func(i int) []float64 { return []float64{r.Update(testCloses[i])} }
+			},
+			order: []string{"rsi"},
+			want:  map[string][]float64{"rsi": {nan, nan, nan, 66.66666666666666, 83.33333333333333, 87.87878787878788, 62.365591397849464, 79.88505747126437}},
+		},
+		{
+			name: "KDJ(3,3,3)",
+			update: func() func(int) []float64 {
+				k := NewKDJ(3, 3, 3)
+				return func(i int) []float64 {
+					v := k.Update(bars[i])
+					return []float64{v.K, v.D, v.J}
+				}
+			},
+			order: []string{"k", "d", "j"},
+			want: map[string][]float64{
+				"k": {nan, nan, 58.333333333333336, 50.0, 58.333333333333336, 65.55555555555556, 54.81481481481482, 61.54320987654321},
+				"d": {nan, nan, 52.77777777777778, 51.85185185185185, 54.01234567901235, 57.86008230452675, 56.84499314128944, 58.41106538637403},
+				"j": {nan, nan, 69.44444444444444, 46.296296296296305, 66.9753086419753, 80.94650205761319, 50.75445816186557, 67.80749885688157},
+			},
+		},
+		{
+			name: "BOLL(3,2)",
+			update: func() func(int) []float64 {
+				b := NewBOLL(3, 2)
+				return func(i int) []float64 {
+					v := b.Update(testCloses[i])
+					return []float64{v.Upper, v.Mid, v.Lower}
+				}
+			},
+			order: []string{"upper", "mid", "lower"},
+			want: map[string][]float64{
+				"upper": {nan, nan, 12.632993161855453, 12.276142374915397, 13.632993161855453, 15.16110492451596, 14.276142374915397, 15.632993161855453},
+				"mid":   {nan, nan, 11, 34.0 / 3, 12, 38.0 / 3, 40.0 / 3, 14},
+				"lower": {nan, nan, 9.367006838144547, 10.390524291751271, 10.367006838144547, 10.172228408817372, 12.390524291751271, 12.367006838144547},
+			},
+		},
+		{
+			name: "ATR(3)",
+			update: func() func(int) []float64 {
+				a := NewATR(3)
+				return func(i int) []float64 { return []float64{a.Update(bars[i])} }
+			},
+			order: []string{"atr"},
+			want:  map[string][]float64{"atr": {nan, nan, 2, 6.5 / 3, 2.5, 8.5 / 3, 8.0 / 3, 8.0 / 3}},
+		},
+		{
+			name: "OBV",
+			update: func() func(int) []float64 {
+				o := NewOBV()
+				return func(i int) []float64 { return []float64{o.Update(bars[i])} }
+			},
+			order: []string{"obv"},
+			want:  map[string][]float64{"obv": {0, 200, 350, 50, 300, 300, -100, 250}},
+		},
+		{
+			name: "VWAP（典型价加权，成交量为 0 的 K 线不计入）",
+			update: func() func(int) []float64 {
+				v := NewVWAP()
+				return func(i int) []float64 { return []float64{v.Update(bars[i])} }
+			},
+			order: []string{"vwap"},
+			want:  map[string][]float64{"vwap": {10, 32.0 / 3, 100.0 / 9, 11.133333333333333, 11.6, 11.6, 12, 12.6}},
+		},
+		{
+			name: "DMI(3,2)",
+			update: func() func(int) []float64 {
+				d := NewDMI(3, 2)
+				return func(i int) []float64 {
+					v := d.Update(bars[i])
+					return []float64{v.PDI, v.MDI, v.ADX, v.ADXR}
+				}
+			},
+			order: []string{"pdi", "mdi", "adx", "adxr"},
+			want: map[string][]float64{
+				"pdi":  {nan, nan, nan, 30.76923076923077, 33.333333333333336, 29.41176470588235, 31.25, 37.5},
+				"mdi":  {nan, nan, nan, 15.384615384615385, 13.333333333333334, 11.764705882352942, 0, 0},
+				"adx":  {nan, nan, nan, nan, 38.095238095238095, 42.857142857142854, 71.42857142857143, 100},
+				"adxr": {nan, nan, nan, nan, nan, nan, 54.76190476190476, 71.42857142857143},
+			},
+		},
+		{
+			name: "VolumeRatio(2)",
+			update: func() func(int) []float64 {
+				r := NewVolumeRatio(2)
+				return func(i int) []float64 { return []float64{r.Update(bars[i])} }
+			},
+			order: []string{"vol_ratio"},
+			want:  map[string][]float64{"vol_ratio": {nan, nan, 1, 12.0 / 7, 10.0 / 9, 0, 3.2, 1.75}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := run(len(bars), tt.update())
+			for j, key := range tt.order {
+				assertSeries(t, key, got[j], tt.want[key])
+			}
+		})
+	}
+}
+
+func TestVWAPWithAmount(t *testing.T) {
+	v := NewVWAP()
+	if got := v.Update(Bar{High: 11, Low: 9, Close: 10}); !math.IsNaN(got) {
+		t.Fatalf("VWAP before any volume = %v, want NaN", got)
+	}
+	v.Update(Bar{High: 11, Low: 9, Close: 10, Volume: 100, Amount: 1050})
+	if got := v.Update(Bar{High: 12, Low: 10, Close: 11, Volume: 100, Amount: 1150}); math.Abs(got-11) > 1e-9 {
+		t.Fatalf("VWAP = %v, want 11 (成交额/成交量)", got)
+	}
+}
+
+func TestRSIFlat(t *testing.T) {
+	r := NewRSI(2)
+	var got float64
+	for i := 0; i < 4; i++ {
+		got = r.Update(10)
+	}
+	if got != 50 {
+		t.Fatalf("RSI of flat closes = %v, want 50", got)
+	}
+}
+
+func TestReady(t *testing.T) {
+	sma, rsi, macd, dmi := NewSMA(3), NewRSI(3), NewMACD(2, 3, 2), NewDMI(3, 2)
+	tests := []struct {
+		name    string
+		ready   int // 累计多少根后 Ready 为 true
+		update  func(b Bar)
+		isReady func() bool
+	}{
+		{"SMA(3)", 3, func(b Bar) { sma.Update(b.Close) }, sma.Ready},
+		{"RSI(3)", 4, func(b Bar) { rsi.Update(b.Close) }, rsi.Ready},
+		{"MACD(2,3,2)", 3, func(b Bar) { macd.Update(b.Close) }, macd.Ready},
+		{"DMI(3,2)", 4, func(b Bar) { dmi.Update(b) }, dmi.Ready},
+	}
+	for _, tt := range tests {
+		for i, b := range testBars() {
+			tt.update(b)
+			if want := i+1 >= tt.ready; tt.isReady() != want {
+				t.Errorf("%s: Ready after %d bars = %v, want %v", tt.name, i+1, !want, want)
+			}
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	bars := testBars()
+	res, err := Compute(bars, []string{" MA ", "vol_ratio"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 8 根不足 MA10/20/60，整段均为预热期
+	for _, key := range []string{"ma10", "ma20", "ma60"} {
+		for i, v := range res["ma"][key] {
+			if !math.IsNaN(v) {
+				t.Errorf("%s[%d] = %v, want NaN", key, i, v)
+			}
+		}
+	}
+	assertSeries(t, "ma5", res["ma"]["ma5"], []float64{nan, nan, nan, nan, 11.4, 12.2, 12.6, 13.2})
+	if _, err := Compute(bars, []string{"nope"}); err == nil {
+		t.Fatal("Compute with unknown indicator: want error")
+	}
+	all, err := Compute(bars, nil)
+	if err != nil || len(all) != len(Names) {
+		t.Fatalf("Compute(nil) = %d indicators, err %v; want %d", len(all), err, len(Names))
+	}
+	tail := res["ma"].Tail(3)
+	assertSeries(t, "tail ma5", tail["ma5"], []float64{12.2, 12.6, 13.2})
+}
+
+func TestSeriesMarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   Series
+		want string
+	}{
+		{Series{}, `[]`},
+		{Series{nan, 1.23456, math.Inf(1), -2}, `[null,1.2346,null,-2.0000]`},
+		{Series{nan, nan}, `[null,null]`},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("Marshal(%v) = %s, want %s", tt.in, b, tt.want)
+		}
+	}
+	// 嵌在 Result 中时同样生效
+	b, _ := json.Marshal(Result{"x": {nan, 1}})
+	if string(b) != `{"x":[null,1.0000]}` {
+		t.Errorf("Marshal(Result) = %s", b)
+	}
+}
+
+func TestSeriesLast(t *testing.T) {
+	if !math.IsNaN(Series(nil).Last()) {
+		t.Error("empty Series.Last() should be NaN")
+	}
+	if got := (Series{1, 2, 3}).Last(); got != 3 {
+		t.Errorf("Last() = %v, want 3", got)
+	}
+}
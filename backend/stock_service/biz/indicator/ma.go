@@ -0,0 +1,78 @@
+package indicator
+
+import "math"
+
+// SMA 简单移动平均 MA(N)
+type SMA struct {
+	w *window
+}
+
+// NewSMA 创建 period 周期的简单移动平均
+func NewSMA(period int) *SMA {
+	return &SMA{w: newWindow(period)}
+}
+
+// Update 输入新值，返回当前均值；不足 period 个值时返回 NaN
+func (s *SMA) Update(v float64) float64 {
+	s.w.push(v)
+	if !s.w.full {
+		return math.NaN()
+	}
+	return s.w.sum / float64(len(s.w.buf))
+}
+
+// Ready 是否已累计满 period 个值
+func (s *SMA) Ready() bool { return s.w.full }
+
+// EMA 指数移动平均 EMA(N)，alpha = 2/(N+1)，首值取原值（通达信口径）
+type EMA struct {
+	alpha float64
+	value float64
+	count int
+}
+
+// NewEMA 创建 period 周期的指数移动平均
+func NewEMA(period int) *EMA {
+	if period < 1 {
+		period = 1
+	}
+	return &EMA{alpha: 2 / float64(period+1)}
+}
+
+// Update 输入新值，返回当前 EMA；EMA 从第一个值起即有输出
+func (e *EMA) Update(v float64) float64 {
+	if e.count == 0 {
+		e.value = v
+	} else {
+		e.value = e.alpha*v + (1-e.alpha)*e.value
+	}
+	e.count++
+	return e.value
+}
+
+// Ready 是否已有输出
+func (e *EMA) Ready() bool { return e.count > 0 }
+
+// wilder 通达信 SMA(X,N,M) 平滑：Y = (M*X + (N-M)*Y') / N，首值取 X
+type wilder struct {
+	n, m  float64
+	value float64
+	count int
+}
+
+func newWilder(n, m int) *wilder {
+	if n < 1 {
+		n = 1
+	}
+	return &wilder{n: float64(n), m: float64(m)}
+}
+
+func (s *wilder) update(v float64) float64 {
+	if s.count == 0 {
+		s.value = v
+	} else {
+		s.value = (s.m*v + (s.n-s.m)*s.value) / s.n
+	}
+	s.count++
+	return s.value
+}
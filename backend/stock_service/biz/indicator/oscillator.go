@@ -0,0 +1,115 @@
+package indicator
+
+import "math"
+
+// MACDValue MACD 单点值；柱 Hist = 2*(DIF-DEA)（国内行情软件口径）
+type MACDValue struct {
+	DIF  float64
+	DEA  float64
+	Hist float64
+}
+
+// MACD 指标，默认参数 (12, 26, 9)
+type MACD struct {
+	fast, slow, signal *EMA
+	slowPeriod         int
+	count              int
+}
+
+// NewMACD 创建 MACD(fast, slow, signal)
+func NewMACD(fast, slow, signal int) *MACD {
+	return &MACD{fast: NewEMA(fast), slow: NewEMA(slow), signal: NewEMA(signal), slowPeriod: slow}
+}
+
+// Update 输入收盘价；累计不足 slow 根时各值为 NaN（EMA 仍在预热）
+func (m *MACD) Update(close float64) MACDValue {
+	dif := m.fast.Update(close) - m.slow.Update(close)
+	dea := m.signal.Update(dif)
+	m.count++
+	if !m.Ready() {
+		return MACDValue{DIF: math.NaN(), DEA: math.NaN(), Hist: math.NaN()}
+	}
+	return MACDValue{DIF: dif, DEA: dea, Hist: 2 * (dif - dea)}
+}
+
+// Ready 是否已累计满 slow 根
+func (m *MACD) Ready() bool { return m.count >= m.slowPeriod }
+
+// RSI 相对强弱：SMA(MAX(C-LC,0),N,1) / SMA(ABS(C-LC),N,1) * 100
+type RSI struct {
+	period    int
+	up, all   *wilder
+	prevClose float64
+	count     int
+}
+
+// NewRSI 创建 period 周期 RSI
+func NewRSI(period int) *RSI {
+	return &RSI{period: period, up: newWilder(period, 1), all: newWilder(period, 1)}
+}
+
+// Update 输入收盘价；需 period+1 根收盘价后才有输出
+func (r *RSI) Update(close float64) float64 {
+	r.count++
+	if r.count == 1 {
+		r.prevClose = close
+		return math.NaN()
+	}
+	diff := close - r.prevClose
+	r.prevClose = close
+	up := r.up.update(math.Max(diff, 0))
+	all := r.all.update(math.Abs(diff))
+	if !r.Ready() {
+		return math.NaN()
+	}
+	if all == 0 {
+		return 50
+	}
+	return up / all * 100
+}
+
+// Ready 是否已累计 period+1 根
+func (r *RSI) Ready() bool { return r.count > r.period }
+
+// KDJValue KDJ 单点值
+type KDJValue struct {
+	K float64
+	D float64
+	J float64
+}
+
+// KDJ 随机指标，默认参数 (9, 3, 3)；K、D 初值 50
+type KDJ struct {
+	highs, lows *window
+	kv, dv      float64
+	count       int
+	period      int
+	kSmooth     int
+	dSmooth     int
+}
+
+// NewKDJ 创建 KDJ(n, m1, m2)
+func NewKDJ(n, m1, m2 int) *KDJ {
+	return &KDJ{highs: newWindow(n), lows: newWindow(n), period: n, kSmooth: m1, dSmooth: m2, kv: 50, dv: 50}
+}
+
+// Update 输入 K 线；不足 n 根时为 NaN
+func (k *KDJ) Update(b Bar) KDJValue {
+	k.highs.push(b.High)
+	k.lows.push(b.Low)
+	k.count++
+	if !k.Ready() {
+		return KDJValue{K: math.NaN(), D: math.NaN(), J: math.NaN()}
+	}
+	hh, ll := k.highs.max(), k.lows.min()
+	rsv := 50.0
+	if hh > ll {
+		rsv = (b.Close - ll) / (hh - ll) * 100
+	}
+	k.kv = (rsv + float64(k.kSmooth-1)*k.kv) / float64(k.kSmooth)
+	k.dv = (k.kv + float64(k.dSmooth-1)*k.dv) / float64(k.dSmooth)
+	return KDJValue{K: k.kv, D: k.dv, J: 3*k.kv - 2*k.dv}
+}
+
+// Ready 是否已累计满 n 根
+func (k *KDJ) Ready() bool { return k.count >= k.period }
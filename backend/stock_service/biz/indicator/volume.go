@@ -0,0 +1,85 @@
+package indicator
+
+import "math"
+
+// OBV 能量潮：收盘上涨累加成交量、下跌累减，首根为 0
+type OBV struct {
+	value     float64
+	prevClose float64
+	count     int
+}
+
+// NewOBV 创建 OBV
+func NewOBV() *OBV {
+	return &OBV{}
+}
+
+// Update 输入 K 线，返回累计 OBV
+func (o *OBV) Update(b Bar) float64 {
+	if o.count > 0 {
+		switch {
+		case b.Close > o.prevClose:
+			o.value += b.Volume
+		case b.Close < o.prevClose:
+			o.value -= b.Volume
+		}
+	}
+	o.prevClose = b.Close
+	o.count++
+	return o.value
+}
+
+// Ready 是否已有输出
+func (o *OBV) Ready() bool { return o.count > 0 }
+
+// VWAP 成交量加权均价，自序列起点锚定累计；有成交额时用 成交额/成交量，否则用典型价 (H+L+C)/3 加权
+type VWAP struct {
+	pv, vol float64
+}
+
+// NewVWAP 创建锚定 VWAP
+func NewVWAP() *VWAP {
+	return &VWAP{}
+}
+
+// Update 输入 K 线；尚无成交量时为 NaN
+func (v *VWAP) Update(b Bar) float64 {
+	if b.Volume > 0 {
+		if b.Amount > 0 {
+			v.pv += b.Amount
+		} else {
+			v.pv += (b.High + b.Low + b.Close) / 3 * b.Volume
+		}
+		v.vol += b.Volume
+	}
+	if !v.Ready() {
+		return math.NaN()
+	}
+	return v.pv / v.vol
+}
+
+// Ready 是否已有成交量
+func (v *VWAP) Ready() bool { return v.vol > 0 }
+
+// VolumeRatio 量比：当根成交量 / 前 N 根均量，默认 N=5
+type VolumeRatio struct {
+	prev *window
+}
+
+// NewVolumeRatio 创建量比，period 为参考均量的根数
+func NewVolumeRatio(period int) *VolumeRatio {
+	return &VolumeRatio{prev: newWindow(period)}
+}
+
+// Update 输入 K 线；前 period 根不足或均量为 0 时为 NaN
+func (r *VolumeRatio) Update(b Bar) float64 {
+	out := math.NaN()
+	if r.prev.full && r.prev.sum > 0 {
+		out = b.Volume / (r.prev.sum / float64(len(r.prev.buf)))
+	}
+	r.prev.push(b.Volume)
+	return out
+}
+
+// Ready 是否已累计满参考根数
+func (r *VolumeRatio) Ready() bool { return r.prev.full }
@@ -0,0 +1,59 @@
+package eastmoney_hk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 东方财富 push2his 个股日资金流向（主力 = 超大单 + 大单），单位：港元
+// 文档参考: push2his.eastmoney.com/api/qt/stock/fflow/daykline/get
+
+const fflowURL = "http://push2his.eastmoney.com/api/qt/stock/fflow/daykline/get"
+
+// DefaultCapitalFlowDays 未指定天数时返回的资金流向天数
+const DefaultCapitalFlowDays = 10
+
+// GetCapitalFlow 获取最近 days 个交易日的资金流向（按日期升序）
+func (c *Client) GetCapitalFlow(ctx context.Context, code string, days int) ([]*stock.CapitalFlow, error) {
+	if days <= 0 {
+		days = DefaultCapitalFlowDays
+	}
+	code = NormalizeHKCode(code)
+	// klines 每行: 日期,主力净流入,小单净流入,中单净流入,大单净流入,超大单净流入
+	url := fmt.Sprintf("%s?secid=%s&fields1=f1,f2,f3,f7&fields2=f51,f52,f53,f54,f55,f56&lmt=%d&ut=fa5fd1943c7b386f172d6893dbfba10b",
+		fflowURL, hkCodeToSecID(code), days)
+	var r klineResp
+	if err := fetchJSON(ctx, url, &r); err != nil {
+		return nil, err
+	}
+	if r.Data == nil {
+		return nil, fmt.Errorf("invalid code or no capital flow data: %s", code)
+	}
+	out := make([]*stock.CapitalFlow, 0, len(r.Data.Klines))
+	for _, line := range r.Data.Klines {
+		fields := strings.Split(line, ",")
+		if len(fields) < 6 {
+			continue
+		}
+		num := func(i int) float64 {
+			v, _ := strconv.ParseFloat(fields[i], 64)
+			return v
+		}
+		out = append(out, &stock.CapitalFlow{
+			Date:          fields[0],
+			MainNet:       num(1),
+			SmallNet:      num(2),
+			MediumNet:     num(3),
+			LargeNet:      num(4),
+			SuperLargeNet: num(5),
+		})
+	}
+	if len(out) > days {
+		out = out[len(out)-days:]
+	}
+	return out, nil
+}
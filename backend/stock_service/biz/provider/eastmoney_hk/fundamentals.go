@@ -0,0 +1,105 @@
+package eastmoney_hk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 东方财富 push2 港股估值与基本面（fltt=2 时直接返回实际数值，无数据的字段为 "-"）
+// 文档参考: push2.eastmoney.com/api/qt/stock/get
+
+// emFloat 兼容数值与 "-" 的字段，"-" 视为 0
+type emFloat float64
+
+func (f *emFloat) UnmarshalJSON(b []byte) error {
+	var v float64
+	if err := json.Unmarshal(b, &v); err != nil {
+		*f = 0
+		return nil
+	}
+	*f = emFloat(v)
+	return nil
+}
+
+// fundamentalsData 估值字段
+type fundamentalsData struct {
+	F43  emFloat `json:"f43"`  // 最新价
+	F55  emFloat `json:"f55"`  // 每股收益
+	F57  string  `json:"f57"`  // 代码
+	F58  string  `json:"f58"`  // 名称
+	F84  emFloat `json:"f84"`  // 总股本
+	F92  emFloat `json:"f92"`  // 每股净资产
+	F116 emFloat `json:"f116"` // 总市值
+	F117 emFloat `json:"f117"` // 流通市值
+	F164 emFloat `json:"f164"` // 市盈率 TTM
+	F167 emFloat `json:"f167"` // 市净率
+	F168 emFloat `json:"f168"` // 换手率 %
+	F173 emFloat `json:"f173"` // ROE %
+	F174 emFloat `json:"f174"` // 52 周最高
+	F175 emFloat `json:"f175"` // 52 周最低
+}
+
+// GetFundamentals 获取港股估值与基本面（市盈率、市净率、市值、每股收益等）
+func (c *Client) GetFundamentals(ctx context.Context, code string) (*stock.Fundamentals, error) {
+	code = NormalizeHKCode(code)
+	fields := "f43,f55,f57,f58,f84,f92,f116,f117,f164,f167,f168,f173,f174,f175"
+	url := fmt.Sprintf("%s?secid=%s&fields=%s&fltt=2&ut=fa5fd1943c7b386f172d6893dbfba10b", push2URL, hkCodeToSecID(code), fields)
+	var r struct {
+		Data *fundamentalsData `json:"data"`
+	}
+	if err := fetchJSON(ctx, url, &r); err != nil {
+		return nil, err
+	}
+	if r.Data == nil || (r.Data.F57 == "" && r.Data.F58 == "") {
+		return nil, fmt.Errorf("invalid code or no data: %s", code)
+	}
+	d := r.Data
+	return &stock.Fundamentals{
+		Code:           code,
+		Name:           d.F58,
+		Price:          float64(d.F43),
+		PeTtm:          float64(d.F164),
+		Pb:             float64(d.F167),
+		MarketCap:      float64(d.F116),
+		FloatMarketCap: float64(d.F117),
+		TotalShares:    int64(d.F84),
+		Eps:            float64(d.F55),
+		Bps:            float64(d.F92),
+		Roe:            float64(d.F173),
+		TurnoverRate:   float64(d.F168),
+		Week52High:     float64(d.F174),
+		Week52Low:      float64(d.F175),
+	}, nil
+}
+
+// fetchJSON GET 东方财富接口并解析 JSON；兼容 jsonp 包装（cb(...)）
+func fetchJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	body = bytes.TrimSpace(body)
+	if i := bytes.IndexByte(body, '('); i > 0 && body[0] != '{' && body[len(body)-1] == ')' {
+		body = body[i+1 : len(body)-1]
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parse response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package eastmoney_hk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 东方财富 push2his 历史 K 线，港股前复权日/周/月线及分钟线
+// 文档参考: push2his.eastmoney.com/api/qt/stock/kline/get
+
+const klineURL = "http://push2his.eastmoney.com/api/qt/stock/kline/get"
+
+// DefaultKlineLimit 未指定条数时返回的 K 线数量
+const DefaultKlineLimit = 120
+
+// klinePeriods 周期名 -> 东方财富 klt 参数
+var klinePeriods = map[string]string{
+	"day":   "101",
+	"week":  "102",
+	"month": "103",
+	"1m":    "1",
+	"5m":    "5",
+	"15m":   "15",
+	"30m":   "30",
+	"60m":   "60",
+}
+
+// codeToKlineSecID 港股代码转 secid；已含市场前缀的 secid（如 100.HSI、124.HSTECH）原样返回
+func codeToKlineSecID(code string) string {
+	if strings.Contains(code, ".") {
+		return code
+	}
+	return hkCodeToSecID(NormalizeHKCode(code))
+}
+
+// klineResp push2his 返回，klines 每行: 日期,开,收,高,低,成交量,成交额
+type klineResp struct {
+	Data *struct {
+		Code   string   `json:"code"`
+		Name   string   `json:"name"`
+		Klines []string `json:"klines"`
+	} `json:"data"`
+}
+
+// GetKline 获取历史 K 线（按日期升序）。period 为空时取日线；endDate 为 YYYYMMDD，空为最新。
+func (c *Client) GetKline(ctx context.Context, code, period string, limit int, endDate string) ([]*stock.KLine, error) {
+	if period == "" {
+		period = "day"
+	}
+	klt, ok := klinePeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unsupported kline period: %s", period)
+	}
+	if limit <= 0 {
+		limit = DefaultKlineLimit
+	}
+	if endDate == "" {
+		endDate = "20500101"
+	}
+	secID := codeToKlineSecID(code)
+	url := fmt.Sprintf("%s?secid=%s&fields1=f1,f2,f3,f4,f5,f6&fields2=f51,f52,f53,f54,f55,f56,f57&klt=%s&fqt=1&end=%s&lmt=%d&ut=fa5fd1943c7b386f172d6893dbfba10b",
+		klineURL, secID, klt, endDate, limit)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var r klineResp
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("parse kline response: %w", err)
+	}
+	if r.Data == nil {
+		return nil, fmt.Errorf("invalid code or no kline data: %s", code)
+	}
+	out := make([]*stock.KLine, 0, len(r.Data.Klines))
+	for _, line := range r.Data.Klines {
+		fields := strings.Split(line, ",")
+		if len(fields) < 7 {
+			continue
+		}
+		open, _ := strconv.ParseFloat(fields[1], 64)
+		closePrice, _ := strconv.ParseFloat(fields[2], 64)
+		high, _ := strconv.ParseFloat(fields[3], 64)
+		low, _ := strconv.ParseFloat(fields[4], 64)
+		volume, _ := strconv.ParseInt(fields[5], 10, 64)
+		amount, _ := strconv.ParseFloat(fields[6], 64)
+		out = append(out, &stock.KLine{
+			Date:   fields[0],
+			Open:   open,
+			Close:  closePrice,
+			High:   high,
+			Low:    low,
+			Volume: volume,
+			Amount: amount,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,185 @@
+package eastmoney_hk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 东方财富 push2 港股全市场列表（涨跌家数、涨跌幅与主力资金排行）与港股通（南向）资金
+// 文档参考: push2.eastmoney.com/api/qt/clist/get、push2.eastmoney.com/api/qt/kamt/get
+
+const (
+	clistURL = "http://push2.eastmoney.com/api/qt/clist/get"
+	kamtURL  = "http://push2.eastmoney.com/api/qt/kamt/get"
+
+	// clistPageSize 单页条数（接口单页上限 100）；clistMaxPages 全市场约 2600 只，留有余量
+	clistPageSize    = 100
+	clistMaxPages    = 40
+	clistConcurrency = 8
+)
+
+// DefaultMoversTopN 未指定时各排行返回的条数
+const DefaultMoversTopN = 10
+
+// clistItem 港股列表字段（fltt=2：f2 港元、f3 百分比，停牌等无数据时为 "-"）
+type clistItem struct {
+	F12 string  `json:"f12"` // 代码
+	F14 string  `json:"f14"` // 名称
+	F2  emFloat `json:"f2"`  // 最新价
+	F3  emFloat `json:"f3"`  // 涨跌幅%
+	F6  emFloat `json:"f6"`  // 成交额
+	F62 emFloat `json:"f62"` // 主力净流入(元)
+}
+
+type clistResp struct {
+	Data *struct {
+		Total int         `json:"total"`
+		Diff  []clistItem `json:"diff"`
+	} `json:"data"`
+}
+
+// Breadth 全市场统计结果
+type Breadth struct {
+	Breadth        *stock.MarketBreadth
+	Gainers        []*stock.MarketMover
+	Losers         []*stock.MarketMover
+	InflowLeaders  []*stock.MarketMover
+	OutflowLeaders []*stock.MarketMover
+}
+
+// GetBreadth 拉取港股全市场列表，统计涨跌家数（仅计当日有成交的股票）并给出涨跌幅、主力净流入前 topN
+func (c *Client) GetBreadth(ctx context.Context, topN int) (*Breadth, error) {
+	if topN <= 0 {
+		topN = DefaultMoversTopN
+	}
+	page := func(pn int) ([]clistItem, int, error) {
+		url := fmt.Sprintf("%s?pn=%d&pz=%d&po=1&np=1&fltt=2&invt=2&fid=f3&fs=m:116+t:3&fields=f12,f14,f2,f3,f6,f62&ut=fa5fd1943c7b386f172d6893dbfba10b",
+			clistURL, pn, clistPageSize)
+		var r clistResp
+		if err := fetchJSON(ctx, url, &r); err != nil {
+			return nil, 0, err
+		}
+		if r.Data == nil {
+			return nil, 0, nil
+		}
+		return r.Data.Diff, r.Data.Total, nil
+	}
+	// 首页得到总数后并发拉取其余页；个别页失败时其余数据仍可用于排行，家数统计会偏少
+	items, total, err := page(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no HK market list data")
+	}
+	pages := min((total+clistPageSize-1)/clistPageSize, clistMaxPages)
+	rest := make([][]clistItem, pages+1)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, clistConcurrency)
+	for pn := 2; pn <= pages; pn++ {
+		wg.Add(1)
+		go func(pn int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			rest[pn], _, _ = page(pn)
+		}(pn)
+	}
+	wg.Wait()
+	for _, list := range rest {
+		items = append(items, list...)
+	}
+
+	b := &Breadth{Breadth: &stock.MarketBreadth{}}
+	traded := make([]clistItem, 0, len(items))
+	for _, it := range items {
+		if it.F2 <= 0 || it.F6 <= 0 {
+			continue
+		}
+		traded = append(traded, it)
+		switch {
+		case it.F3 > 0:
+			b.Breadth.Advancers++
+		case it.F3 < 0:
+			b.Breadth.Decliners++
+		default:
+			b.Breadth.Unchanged++
+		}
+	}
+	top := func(less func(a, b clistItem) bool) []*stock.MarketMover {
+		sorted := make([]clistItem, len(traded))
+		copy(sorted, traded)
+		sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+		if len(sorted) > topN {
+			sorted = sorted[:topN]
+		}
+		out := make([]*stock.MarketMover, 0, len(sorted))
+		for _, it := range sorted {
+			out = append(out, &stock.MarketMover{
+				Code:          "hk" + it.F12,
+				Name:          it.F14,
+				Price:         float64(it.F2),
+				ChangePercent: float64(it.F3),
+				MainNetInflow: float64(it.F62),
+			})
+		}
+		return out
+	}
+	b.Gainers = top(func(x, y clistItem) bool { return x.F3 > y.F3 })
+	b.Losers = top(func(x, y clistItem) bool { return x.F3 < y.F3 })
+	b.InflowLeaders = top(func(x, y clistItem) bool { return x.F62 > y.F62 })
+	b.OutflowLeaders = top(func(x, y clistItem) bool { return x.F62 < y.F62 })
+	return b, nil
+}
+
+// kamtChannel 港股通单个通道，金额单位：万元人民币
+type kamtChannel struct {
+	NetBuyAmt    emFloat `json:"netBuyAmt"`    // 成交净买额
+	DayNetAmtIn  emFloat `json:"dayNetAmtIn"`  // 当日资金净流入
+	DayAmtRemain emFloat `json:"dayAmtRemain"` // 当日余额
+}
+
+type kamtResp struct {
+	Data *struct {
+		SH2HK *kamtChannel `json:"sh2hk"` // 港股通（沪）
+		SZ2HK *kamtChannel `json:"sz2hk"` // 港股通（深）
+	} `json:"data"`
+}
+
+// GetSouthbound 港股通（南向）当日净买入与额度余额，单位：亿元人民币
+func (c *Client) GetSouthbound(ctx context.Context) ([]*stock.SouthboundFlow, error) {
+	url := kamtURL + "?fields1=f1,f2,f3,f4&fields2=f51,f52,f53,f54,f56,f62,f63,f65,f66&ut=b2884a393a59ad64002292a3e90d46a5"
+	var r kamtResp
+	if err := fetchJSON(ctx, url, &r); err != nil {
+		return nil, err
+	}
+	if r.Data == nil {
+		return nil, fmt.Errorf("no southbound data")
+	}
+	var out []*stock.SouthboundFlow
+	for _, ch := range []struct {
+		name string
+		data *kamtChannel
+	}{{"港股通(沪)", r.Data.SH2HK}, {"港股通(深)", r.Data.SZ2HK}} {
+		if ch.data == nil {
+			continue
+		}
+		net := ch.data.NetBuyAmt
+		if net == 0 {
+			net = ch.data.DayNetAmtIn
+		}
+		out = append(out, &stock.SouthboundFlow{
+			Channel:     ch.name,
+			NetBuy:      float64(net) / 1e4,
+			QuotaRemain: float64(ch.data.DayAmtRemain) / 1e4,
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no southbound data")
+	}
+	return out, nil
+}
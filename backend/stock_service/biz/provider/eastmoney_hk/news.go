@@ -0,0 +1,78 @@
+package eastmoney_hk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"hk_stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// 东方财富搜索资讯（按关键字检索新闻，按时间倒序）
+// 文档参考: search-api-web.eastmoney.com/search/jsonp
+
+const newsSearchURL = "https://search-api-web.eastmoney.com/search/jsonp"
+
+// DefaultNewsLimit 未指定条数时返回的新闻数量
+const DefaultNewsLimit = 10
+
+// highlightRe 搜索结果中的高亮标签
+var highlightRe = regexp.MustCompile(`</?em>`)
+
+// SearchNews 按关键字（公司名或代码）搜索最新资讯
+func (c *Client) SearchNews(ctx context.Context, keyword string, limit int) ([]*stock.StockNews, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, fmt.Errorf("empty news keyword")
+	}
+	if limit <= 0 || limit > 50 {
+		limit = DefaultNewsLimit
+	}
+	param, _ := json.Marshal(map[string]interface{}{
+		"uid":           "",
+		"keyword":       keyword,
+		"type":          []string{"cmsArticleWebOld"},
+		"client":        "web",
+		"clientType":    "web",
+		"clientVersion": "curr",
+		"param": map[string]interface{}{
+			"cmsArticleWebOld": map[string]interface{}{
+				"searchScope": "default",
+				"sort":        "time",
+				"pageIndex":   1,
+				"pageSize":    limit,
+				"preTag":      "",
+				"postTag":     "",
+			},
+		},
+	})
+	u := fmt.Sprintf("%s?cb=cb&param=%s", newsSearchURL, url.QueryEscape(string(param)))
+	var r struct {
+		Result struct {
+			Articles []struct {
+				Title     string `json:"title"`
+				Date      string `json:"date"`
+				MediaName string `json:"mediaName"`
+				URL       string `json:"url"`
+				Content   string `json:"content"`
+			} `json:"cmsArticleWebOld"`
+		} `json:"result"`
+	}
+	if err := fetchJSON(ctx, u, &r); err != nil {
+		return nil, err
+	}
+	out := make([]*stock.StockNews, 0, len(r.Result.Articles))
+	for _, a := range r.Result.Articles {
+		out = append(out, &stock.StockNews{
+			Title:   highlightRe.ReplaceAllString(a.Title, ""),
+			Time:    a.Date,
+			Source:  a.MediaName,
+			Url:     a.URL,
+			Summary: highlightRe.ReplaceAllString(a.Content, ""),
+		})
+	}
+	return out, nil
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"hk_stock_assistant/backend/stock_service/biz/provider/eastmoney_hk"
 	"hk_stock_assistant/backend/stock_service/biz/provider/sina_hk"
@@ -38,6 +40,65 @@ func (s *StockServiceImpl) GetRealtime(ctx context.Context, req *stock.GetRealti
 
 // GetMarketSummary implements stock.StockService（恒生指数 + 恒生科技指数，优先东方财富）
 func (s *StockServiceImpl) GetMarketSummary(ctx context.Context, req *stock.GetMarketSummaryRequest) (*stock.GetMarketSummaryResponse, error) {
+	indices, err := s.marketIndices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &stock.GetMarketSummaryResponse{Indices: indices}, nil
+}
+
+// GetMarketOverview implements stock.StockService（大盘指数、涨跌家数、涨跌幅与主力资金排行、港股通南向资金）
+// 各部分并行获取，单项失败时记入 warnings 并返回其余部分；全部失败才返回错误
+func (s *StockServiceImpl) GetMarketOverview(ctx context.Context, req *stock.GetMarketOverviewRequest) (*stock.GetMarketOverviewResponse, error) {
+	topN := eastmoney_hk.DefaultMoversTopN
+	if req != nil && req.TopN > 0 {
+		topN = int(req.TopN)
+	}
+	resp := &stock.GetMarketOverviewResponse{Timestamp: time.Now().In(hkLocation).Format("2006-01-02 15:04:05")}
+	var (
+		wg                             sync.WaitGroup
+		indexErr, breadthErr, southErr error
+		breadth                        *eastmoney_hk.Breadth
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		resp.Indices, indexErr = s.marketIndices(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		breadth, breadthErr = s.stockClient.GetBreadth(ctx, topN)
+	}()
+	go func() {
+		defer wg.Done()
+		resp.Southbound, southErr = s.stockClient.GetSouthbound(ctx)
+	}()
+	wg.Wait()
+
+	if indexErr != nil {
+		resp.Warnings = append(resp.Warnings, "indices: "+indexErr.Error())
+	}
+	if breadthErr != nil {
+		resp.Warnings = append(resp.Warnings, "breadth: "+breadthErr.Error())
+	} else {
+		resp.Breadth = breadth.Breadth
+		resp.Gainers, resp.Losers = breadth.Gainers, breadth.Losers
+		resp.InflowLeaders, resp.OutflowLeaders = breadth.InflowLeaders, breadth.OutflowLeaders
+	}
+	if southErr != nil {
+		resp.Warnings = append(resp.Warnings, "southbound: "+southErr.Error())
+	}
+	if indexErr != nil && breadthErr != nil && southErr != nil {
+		return nil, fmt.Errorf("failed to fetch HK market overview: %v", resp.Warnings)
+	}
+	return resp, nil
+}
+
+// hkLocation 港股时区，行情时间戳统一为香港时间
+var hkLocation = time.FixedZone("HKT", 8*3600)
+
+// marketIndices 恒生指数 + 恒生科技指数，均失败时返回错误
+func (s *StockServiceImpl) marketIndices(ctx context.Context) ([]*stock.MarketIndex, error) {
 	indices := make([]*stock.MarketIndex, 0)
 	// 恒生指数：优先东方财富 100.HSI，失败则新浪 int_hangseng
 	name, value, change, changePct, err := s.stockClient.GetIndexInfo(ctx, "100.HSI")
@@ -64,5 +125,53 @@ func (s *StockServiceImpl) GetMarketSummary(ctx context.Context, req *stock.GetM
 	if len(indices) == 0 {
 		return nil, fmt.Errorf("failed to fetch any HK market indices")
 	}
-	return &stock.GetMarketSummaryResponse{Indices: indices}, nil
+	return indices, nil
+}
+
+// GetKline implements stock.StockService（东方财富 push2his 历史 K 线，前复权）
+func (s *StockServiceImpl) GetKline(ctx context.Context, req *stock.GetKlineRequest) (*stock.GetKlineResponse, error) {
+	if req == nil || req.Code == "" {
+		return &stock.GetKlineResponse{}, nil
+	}
+	klines, err := s.stockClient.GetKline(ctx, req.Code, req.Period, int(req.Limit), req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return &stock.GetKlineResponse{Klines: klines}, nil
+}
+
+// GetFundamentals implements stock.StockService（东方财富估值与基本面）
+func (s *StockServiceImpl) GetFundamentals(ctx context.Context, req *stock.GetFundamentalsRequest) (*stock.GetFundamentalsResponse, error) {
+	if req == nil || req.Code == "" {
+		return &stock.GetFundamentalsResponse{}, nil
+	}
+	f, err := s.stockClient.GetFundamentals(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &stock.GetFundamentalsResponse{Fundamentals: f}, nil
+}
+
+// GetCapitalFlow implements stock.StockService（东方财富日资金流向）
+func (s *StockServiceImpl) GetCapitalFlow(ctx context.Context, req *stock.GetCapitalFlowRequest) (*stock.GetCapitalFlowResponse, error) {
+	if req == nil || req.Code == "" {
+		return &stock.GetCapitalFlowResponse{}, nil
+	}
+	flows, err := s.stockClient.GetCapitalFlow(ctx, req.Code, int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+	return &stock.GetCapitalFlowResponse{Flows: flows}, nil
+}
+
+// SearchNews implements stock.StockService（东方财富资讯搜索）
+func (s *StockServiceImpl) SearchNews(ctx context.Context, req *stock.SearchNewsRequest) (*stock.SearchNewsResponse, error) {
+	if req == nil || req.Keyword == "" {
+		return &stock.SearchNewsResponse{}, nil
+	}
+	items, err := s.stockClient.SearchNews(ctx, req.Keyword, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &stock.SearchNewsResponse{Items: items}, nil
 }
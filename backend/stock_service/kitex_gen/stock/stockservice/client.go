@@ -13,6 +13,11 @@ import (
 type Client interface {
 	GetRealtime(ctx context.Context, req *stock.GetRealtimeRequest, callOptions ...callopt.Option) (r *stock.GetRealtimeResponse, err error)
 	GetMarketSummary(ctx context.Context, req *stock.GetMarketSummaryRequest, callOptions ...callopt.Option) (r *stock.GetMarketSummaryResponse, err error)
+	GetKline(ctx context.Context, req *stock.GetKlineRequest, callOptions ...callopt.Option) (r *stock.GetKlineResponse, err error)
+	GetFundamentals(ctx context.Context, req *stock.GetFundamentalsRequest, callOptions ...callopt.Option) (r *stock.GetFundamentalsResponse, err error)
+	GetCapitalFlow(ctx context.Context, req *stock.GetCapitalFlowRequest, callOptions ...callopt.Option) (r *stock.GetCapitalFlowResponse, err error)
+	SearchNews(ctx context.Context, req *stock.SearchNewsRequest, callOptions ...callopt.Option) (r *stock.SearchNewsResponse, err error)
+	GetMarketOverview(ctx context.Context, req *stock.GetMarketOverviewRequest, callOptions ...callopt.Option) (r *stock.GetMarketOverviewResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +58,28 @@ func (p *kStockServiceClient) GetMarketSummary(ctx context.Context, req *stock.G
 	return p.kClient.GetMarketSummary(ctx, req)
 }
 
+func (p *kStockServiceClient) GetKline(ctx context.Context, req *stock.GetKlineRequest, callOptions ...callopt.Option) (r *stock.GetKlineResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetKline(ctx, req)
+}
+
+func (p *kStockServiceClient) GetFundamentals(ctx context.Context, req *stock.GetFundamentalsRequest, callOptions ...callopt.Option) (r *stock.GetFundamentalsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFundamentals(ctx, req)
+}
+
+func (p *kStockServiceClient) GetCapitalFlow(ctx context.Context, req *stock.GetCapitalFlowRequest, callOptions ...callopt.Option) (r *stock.GetCapitalFlowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCapitalFlow(ctx, req)
+}
+
+func (p *kStockServiceClient) SearchNews(ctx context.Context, req *stock.SearchNewsRequest, callOptions ...callopt.Option) (r *stock.SearchNewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchNews(ctx, req)
+}
+
+func (p *kStockServiceClient) GetMarketOverview(ctx context.Context, req *stock.GetMarketOverviewRequest, callOptions ...callopt.Option) (r *stock.GetMarketOverviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarketOverview(ctx, req)
+}
+
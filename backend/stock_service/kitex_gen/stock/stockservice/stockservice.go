@@ -27,6 +27,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetKline": kitex.NewMethodInfo(
+		getKlineHandler,
+		newStockServiceGetKlineArgs,
+		newStockServiceGetKlineResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFundamentals": kitex.NewMethodInfo(
+		getFundamentalsHandler,
+		newStockServiceGetFundamentalsArgs,
+		newStockServiceGetFundamentalsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCapitalFlow": kitex.NewMethodInfo(
+		getCapitalFlowHandler,
+		newStockServiceGetCapitalFlowArgs,
+		newStockServiceGetCapitalFlowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SearchNews": kitex.NewMethodInfo(
+		searchNewsHandler,
+		newStockServiceSearchNewsArgs,
+		newStockServiceSearchNewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarketOverview": kitex.NewMethodInfo(
+		getMarketOverviewHandler,
+		newStockServiceGetMarketOverviewArgs,
+		newStockServiceGetMarketOverviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -129,6 +164,96 @@ func newStockServiceGetMarketSummaryResult() interface{} {
 	return stock.NewStockServiceGetMarketSummaryResult()
 }
 
+func getKlineHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetKlineArgs)
+	realResult := result.(*stock.StockServiceGetKlineResult)
+	success, err := handler.(stock.StockService).GetKline(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetKlineArgs() interface{} {
+	return stock.NewStockServiceGetKlineArgs()
+}
+
+func newStockServiceGetKlineResult() interface{} {
+	return stock.NewStockServiceGetKlineResult()
+}
+
+func getFundamentalsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetFundamentalsArgs)
+	realResult := result.(*stock.StockServiceGetFundamentalsResult)
+	success, err := handler.(stock.StockService).GetFundamentals(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetFundamentalsArgs() interface{} {
+	return stock.NewStockServiceGetFundamentalsArgs()
+}
+
+func newStockServiceGetFundamentalsResult() interface{} {
+	return stock.NewStockServiceGetFundamentalsResult()
+}
+
+func getCapitalFlowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetCapitalFlowArgs)
+	realResult := result.(*stock.StockServiceGetCapitalFlowResult)
+	success, err := handler.(stock.StockService).GetCapitalFlow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetCapitalFlowArgs() interface{} {
+	return stock.NewStockServiceGetCapitalFlowArgs()
+}
+
+func newStockServiceGetCapitalFlowResult() interface{} {
+	return stock.NewStockServiceGetCapitalFlowResult()
+}
+
+func searchNewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceSearchNewsArgs)
+	realResult := result.(*stock.StockServiceSearchNewsResult)
+	success, err := handler.(stock.StockService).SearchNews(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceSearchNewsArgs() interface{} {
+	return stock.NewStockServiceSearchNewsArgs()
+}
+
+func newStockServiceSearchNewsResult() interface{} {
+	return stock.NewStockServiceSearchNewsResult()
+}
+
+func getMarketOverviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetMarketOverviewArgs)
+	realResult := result.(*stock.StockServiceGetMarketOverviewResult)
+	success, err := handler.(stock.StockService).GetMarketOverview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetMarketOverviewArgs() interface{} {
+	return stock.NewStockServiceGetMarketOverviewArgs()
+}
+
+func newStockServiceGetMarketOverviewResult() interface{} {
+	return stock.NewStockServiceGetMarketOverviewResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -158,3 +283,53 @@ func (p *kClient) GetMarketSummary(ctx context.Context, req *stock.GetMarketSumm
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetKline(ctx context.Context, req *stock.GetKlineRequest) (r *stock.GetKlineResponse, err error) {
+	var _args stock.StockServiceGetKlineArgs
+	_args.Req = req
+	var _result stock.StockServiceGetKlineResult
+	if err = p.c.Call(ctx, "GetKline", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFundamentals(ctx context.Context, req *stock.GetFundamentalsRequest) (r *stock.GetFundamentalsResponse, err error) {
+	var _args stock.StockServiceGetFundamentalsArgs
+	_args.Req = req
+	var _result stock.StockServiceGetFundamentalsResult
+	if err = p.c.Call(ctx, "GetFundamentals", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCapitalFlow(ctx context.Context, req *stock.GetCapitalFlowRequest) (r *stock.GetCapitalFlowResponse, err error) {
+	var _args stock.StockServiceGetCapitalFlowArgs
+	_args.Req = req
+	var _result stock.StockServiceGetCapitalFlowResult
+	if err = p.c.Call(ctx, "GetCapitalFlow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchNews(ctx context.Context, req *stock.SearchNewsRequest) (r *stock.SearchNewsResponse, err error) {
+	var _args stock.StockServiceSearchNewsArgs
+	_args.Req = req
+	var _result stock.StockServiceSearchNewsResult
+	if err = p.c.Call(ctx, "SearchNews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarketOverview(ctx context.Context, req *stock.GetMarketOverviewRequest) (r *stock.GetMarketOverviewResponse, err error) {
+	var _args stock.StockServiceGetMarketOverviewArgs
+	_args.Req = req
+	var _result stock.StockServiceGetMarketOverviewResult
+	if err = p.c.Call(ctx, "GetMarketOverview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
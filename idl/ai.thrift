@@ -1,10 +1,155 @@
 namespace go ai
 
+struct TechnicalIndicators {
+    1: string as_of
+    2: i32 bars
+    3: double close
+    4: double ma5
+    5: double ma10
+    6: double ma20
+    7: double ma60
+    8: double macd_dif
+    9: double macd_dea
+    10: double macd_hist
+    11: double rsi6
+    12: double rsi12
+    13: double rsi24
+    14: double kdj_k
+    15: double kdj_d
+    16: double kdj_j
+    17: double boll_upper
+    18: double boll_mid
+    19: double boll_lower
+    20: double volume_ratio
+    21: double high20
+    22: double low20
+}
+
+struct Verdict {
+    1: string direction
+    2: double confidence
+    3: double change_low_pct
+    4: double change_high_pct
+    5: double price_low
+    6: double price_high
+}
+
+struct ToolInvocation {
+    1: i32 step
+    2: string name
+    3: string arguments
+    4: string result
+    5: string error
+    6: i64 elapsed_ms
+    7: string role
+    8: string model
+}
+
+struct Debate {
+    1: string bull
+    2: string bear
+}
+
+struct PriceBandPoint {
+    1: i32 day
+    2: double p5
+    3: double p25
+    4: double p50
+    5: double p75
+    6: double p95
+}
+
+struct PriceBands {
+    1: string model
+    2: i32 days
+    3: i32 paths
+    4: i32 lookback
+    5: double price
+    6: double daily_vol_pct
+    7: double drift_pct
+    8: list<PriceBandPoint> points
+}
+
 struct PredictionResult {
     1: string code
     2: double confidence
     3: string analysis
     4: string news_summary
+    5: optional TechnicalIndicators technical
+    6: optional Verdict verdict
+    7: string model
+    8: string template_version
+    9: string prediction_id
+    10: list<ToolInvocation> tool_calls
+    11: string mode
+    12: optional Debate debate
+    13: bool cached
+    14: optional PriceBands bands
+    15: double raw_confidence
+    16: bool calibrated
+    17: optional Ensemble ensemble
+    18: list<VerificationWarning> warnings
+    19: string language
+    20: optional DataSnapshot context
+}
+
+struct SnapshotQuote {
+    1: string code
+    2: string name
+    3: double current_price
+    4: double change_percent
+    5: i64 volume
+    6: string timestamp
+}
+
+struct SnapshotIndex {
+    1: string name
+    2: double value
+    3: double change
+    4: double change_percent
+}
+
+struct DataSource {
+    1: string name
+    2: bool ok
+    3: bool critical
+    4: string error
+    5: string fetched_at
+    6: i64 elapsed_ms
+}
+
+struct DataSnapshot {
+    1: string code
+    2: string time
+    3: bool is_trading
+    4: optional SnapshotQuote quote
+    5: list<SnapshotIndex> indices
+    6: i32 bars
+    7: string last_bar_date
+    8: list<DataSource> sources
+}
+
+struct VerificationWarning {
+    1: string kind
+    2: string message
+    3: double cited
+    4: double expected
+    5: string model
+}
+
+struct EnsembleMember {
+    1: string model
+    2: double weight
+    3: double accuracy
+    4: i32 samples
+    5: optional PredictionResult result
+    6: string error
+}
+
+struct Ensemble {
+    1: list<EnsembleMember> members
+    2: map<string, double> votes
+    3: double agreement
 }
 
 struct GetPredictionRequest {
@@ -12,12 +157,228 @@ struct GetPredictionRequest {
     2: i32 days
     3: bool include_news
     4: string model
+    5: string template_version
+    6: string mode
+    7: bool force_refresh
+    8: list<string> models
+    9: string language
 }
 
 struct GetPredictionResponse {
     1: PredictionResult result
 }
 
+struct ChatMessage {
+    1: string role
+    2: string content
+    3: string time
+}
+
+struct ChatSession {
+    1: string id
+    2: string code
+    3: string prediction_id
+    4: string model
+    5: list<ChatMessage> messages
+    6: string created_at
+    7: string updated_at
+}
+
+struct CreateChatSessionRequest {
+    1: string code
+    2: string prediction_id
+    3: string model
+    4: optional string language
+}
+
+struct CreateChatSessionResponse {
+    1: ChatSession session
+}
+
+struct GetChatSessionRequest {
+    1: string id
+}
+
+struct GetChatSessionResponse {
+    1: optional ChatSession session
+}
+
+struct PredictionJob {
+    1: string id
+    2: string status
+    3: string stage
+    4: i32 progress
+    5: string code
+    6: string created_at
+    7: string started_at
+    8: string finished_at
+    9: string error
+    10: optional PredictionResult result
+    11: i32 queue_position
+}
+
+struct SubmitPredictionJobRequest {
+    1: GetPredictionRequest prediction
+}
+
+struct SubmitPredictionJobResponse {
+    1: PredictionJob job
+}
+
+struct GetPredictionJobRequest {
+    1: string id
+}
+
+struct GetPredictionJobResponse {
+    1: optional PredictionJob job
+}
+
+struct CancelPredictionJobRequest {
+    1: string id
+}
+
+struct CancelPredictionJobResponse {
+    1: optional PredictionJob job
+}
+
+struct UsageTotals {
+    1: string key
+    2: i64 calls
+    3: i64 prompt_tokens
+    4: i64 completion_tokens
+    5: i64 reasoning_tokens
+    6: i64 total_tokens
+    7: double cost
+    8: i64 estimated_calls
+    9: bool unpriced
+}
+
+struct UsageDay {
+    1: string date
+    2: UsageTotals total
+    3: list<UsageTotals> by_model
+    4: list<UsageTotals> by_endpoint
+}
+
+struct UsageBudget {
+    1: double daily
+    2: string action
+    3: string fallback_model
+    4: double today_cost
+    5: bool exceeded
+    6: string currency
+}
+
+struct GetUsageRequest {
+    1: i32 days
+}
+
+struct GetUsageResponse {
+    1: list<UsageDay> days
+    2: UsageBudget budget
+}
+
+struct CalibrationPoint {
+    1: double raw
+    2: double calibrated
+}
+
+struct CalibrationBin {
+    1: double low
+    2: double high
+    3: i32 count
+    4: double avg_raw
+    5: double avg_calibrated
+    6: double hit_rate
+}
+
+struct CalibrationGroup {
+    1: string model
+    2: string template_version
+    3: i32 samples
+    4: i32 rated
+    5: double base_rate
+    6: double brier_raw
+    7: double brier_calibrated
+    8: list<CalibrationPoint> curve
+    9: list<CalibrationBin> bins
+}
+
+struct GetCalibrationRequest {
+    1: bool refit
+}
+
+struct GetCalibrationResponse {
+    1: string fitted_at
+    2: i32 samples
+    3: i32 pending
+    4: i32 min_samples
+    5: list<CalibrationGroup> groups
+}
+
+struct MarketCommentary {
+    1: string session_date
+    2: string phase
+    3: string commentary
+    4: string model
+    5: string template_version
+    6: string language
+    7: string generated_at
+    8: string expires_at
+    9: bool cached
+    10: list<string> data_warnings
+}
+
+struct GetMarketCommentaryRequest {
+    1: string language
+    2: bool force_refresh
+}
+
+struct GetMarketCommentaryResponse {
+    1: MarketCommentary commentary
+}
+
+struct CompareStock {
+    1: string code
+    2: string name
+    3: double price
+    4: double change_percent
+    5: i32 rank
+    6: Verdict verdict
+    7: string reason
+    8: TechnicalIndicators technical
+    9: PriceBands bands
+    10: string prediction_id
+    11: optional DataSnapshot context
+}
+
+struct ComparePredictionRequest {
+    1: list<string> codes
+    2: i32 days
+    3: string model
+    4: string template_version
+    5: string language
+}
+
+struct ComparePredictionResponse {
+    1: i32 days
+    2: string model
+    3: string template_version
+    4: string language
+    5: string analysis
+    6: list<CompareStock> stocks
+    7: list<VerificationWarning> warnings
+}
+
 service AIService {
     GetPredictionResponse GetPrediction(1: GetPredictionRequest req)
+    CreateChatSessionResponse CreateChatSession(1: CreateChatSessionRequest req)
+    GetChatSessionResponse GetChatSession(1: GetChatSessionRequest req)
+    SubmitPredictionJobResponse SubmitPredictionJob(1: SubmitPredictionJobRequest req)
+    GetPredictionJobResponse GetPredictionJob(1: GetPredictionJobRequest req)
+    CancelPredictionJobResponse CancelPredictionJob(1: CancelPredictionJobRequest req)
+    GetUsageResponse GetUsage(1: GetUsageRequest req)
+    GetCalibrationResponse GetCalibration(1: GetCalibrationRequest req)
+    GetMarketCommentaryResponse GetMarketCommentary(1: GetMarketCommentaryRequest req)
+    ComparePredictionResponse ComparePrediction(1: ComparePredictionRequest req)
 }
@@ -31,7 +31,129 @@ struct GetMarketSummaryResponse {
     1: list<MarketIndex> indices
 }
 
+struct KLine {
+    1: string date
+    2: double open
+    3: double close
+    4: double high
+    5: double low
+    6: i64 volume
+    7: double amount
+}
+
+struct GetKlineRequest {
+    1: string code
+    2: string period
+    3: i32 limit
+    4: string end_date
+}
+
+struct GetKlineResponse {
+    1: list<KLine> klines
+}
+
+struct Fundamentals {
+    1: string code
+    2: string name
+    3: double price
+    4: double pe_ttm
+    5: double pb
+    6: double market_cap
+    7: double float_market_cap
+    8: i64 total_shares
+    9: double eps
+    10: double bps
+    11: double roe
+    12: double turnover_rate
+    13: double week52_high
+    14: double week52_low
+}
+
+struct GetFundamentalsRequest {
+    1: string code
+}
+
+struct GetFundamentalsResponse {
+    1: Fundamentals fundamentals
+}
+
+struct CapitalFlow {
+    1: string date
+    2: double main_net
+    3: double super_large_net
+    4: double large_net
+    5: double medium_net
+    6: double small_net
+}
+
+struct GetCapitalFlowRequest {
+    1: string code
+    2: i32 days
+}
+
+struct GetCapitalFlowResponse {
+    1: list<CapitalFlow> flows
+}
+
+struct StockNews {
+    1: string title
+    2: string time
+    3: string source
+    4: string url
+    5: string summary
+}
+
+struct SearchNewsRequest {
+    1: string keyword
+    2: i32 limit
+}
+
+struct SearchNewsResponse {
+    1: list<StockNews> items
+}
+
+struct MarketMover {
+    1: string code
+    2: string name
+    3: double price
+    4: double change_percent
+    5: double main_net_inflow
+}
+
+struct MarketBreadth {
+    1: i32 advancers
+    2: i32 decliners
+    3: i32 unchanged
+}
+
+struct SouthboundFlow {
+    1: string channel
+    2: double net_buy
+    3: double quota_remain
+}
+
+struct GetMarketOverviewRequest {
+    1: i32 top_n
+}
+
+struct GetMarketOverviewResponse {
+    1: list<MarketIndex> indices
+    2: MarketBreadth breadth
+    3: list<MarketMover> gainers
+    4: list<MarketMover> losers
+    5: list<MarketMover> inflow_leaders
+    6: list<MarketMover> outflow_leaders
+    7: list<SouthboundFlow> southbound
+    8: string timestamp
+    9: list<string> warnings
+}
+
 service StockService {
     GetRealtimeResponse GetRealtime(1: GetRealtimeRequest req)
     GetMarketSummaryResponse GetMarketSummary(1: GetMarketSummaryRequest req)
+    GetKlineResponse GetKline(1: GetKlineRequest req)
+    GetFundamentalsResponse GetFundamentals(1: GetFundamentalsRequest req)
+    GetCapitalFlowResponse GetCapitalFlow(1: GetCapitalFlowRequest req)
+    SearchNewsResponse SearchNews(1: SearchNewsRequest req)
+    GetMarketOverviewResponse GetMarketOverview(1: GetMarketOverviewRequest req)
 }
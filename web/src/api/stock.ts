@@ -4,7 +4,21 @@ import type {
   MarketSummaryResponse,
   PredictionResponse,
   PredictionRequest,
+  DebateRole,
+  LLMWaitStatus,
+  PriceBands,
+  EnsembleMember,
+  VerificationWarning,
   SectorsResponse,
+  MarketOverview,
+  MarketCommentary,
+  OutputLanguage,
+  BatchPredictionRequest,
+  BatchItem,
+  Digest,
+  CompareRequest,
+  CompareResponse,
+  DataSnapshot,
 } from '../types'
 
 function normalizeCode(code: string): string {
@@ -36,21 +50,130 @@ export async function getSectors(): Promise<SectorsResponse> {
   return data
 }
 
+/** AI 大市点评（SSE）：onOverview 收到生成所用的市场数据，onChunk 为点评正文增量，onCommentary 为完整点评；本时段已生成时为重放。 */
+export function getMarketCommentaryStream(
+  opts: { language?: OutputLanguage; force_refresh?: boolean },
+  callbacks: {
+    onOverview?: (overview: MarketOverview) => void
+    onChunk: (event: 'reasoning' | 'content', text: string) => void
+    onCommentary: (commentary: MarketCommentary) => void
+    onDone: () => void
+    onError: (message: string) => void
+  }
+): () => void {
+  const abort = new AbortController()
+  const baseURL = client.defaults.baseURL || ''
+  const params = new URLSearchParams()
+  if (opts.language) params.set('language', opts.language)
+  if (opts.force_refresh) params.set('force_refresh', 'true')
+  fetch(`${baseURL}/api/market/commentary/stream?${params}`, { signal: abort.signal })
+    .then(async (res) => {
+      if (!res.ok) {
+        const text = await res.text()
+        callbacks.onError(text || `请求失败 ${res.status}`)
+        return
+      }
+      const reader = res.body?.getReader()
+      if (!reader) {
+        callbacks.onError('不支持流式响应')
+        return
+      }
+      const dec = new TextDecoder()
+      let buffer = ''
+      try {
+        while (true) {
+          const { done, value } = await reader.read()
+          if (done) break
+          buffer += dec.decode(value, { stream: true })
+          const parts = buffer.split('\n\n')
+          buffer = parts.pop() ?? ''
+          for (const part of parts) {
+            let event = ''
+            let data = ''
+            for (const line of part.split('\n')) {
+              if (line.startsWith('event: ')) event = line.slice(7).trim()
+              else if (line.startsWith('data: ')) data = line.slice(6)
+            }
+            try {
+              if (event === 'overview') callbacks.onOverview?.(JSON.parse(data) as MarketOverview)
+              else if (event === 'reasoning' || event === 'content') callbacks.onChunk(event, JSON.parse(data) as string)
+              else if (event === 'commentary') callbacks.onCommentary(JSON.parse(data) as MarketCommentary)
+              else if (event === 'error') {
+                callbacks.onError(JSON.parse(data) as string)
+                return
+              } else if (event === 'done') {
+                callbacks.onDone()
+                return
+              }
+            } catch {
+              if (event === 'error') {
+                callbacks.onError(data)
+                return
+              }
+            }
+          }
+        }
+        callbacks.onDone()
+      } finally {
+        reader.releaseLock()
+      }
+    })
+    .catch((e: unknown) => {
+      if (e instanceof Error && e.name === 'AbortError') return
+      callbacks.onError(e instanceof Error ? e.message : String(e))
+    })
+  return () => abort.abort()
+}
+
 export async function getPrediction(req: PredictionRequest): Promise<PredictionResponse> {
   const code = normalizeCode(req.code)
   const { data } = await client.post<PredictionResponse>(
     `/api/prediction/${encodeURIComponent(code)}`,
-    { days: req.days, include_news: req.include_news, model: req.model },
+    {
+      days: req.days,
+      include_news: req.include_news,
+      model: req.model,
+      mode: req.mode,
+      force_refresh: req.force_refresh,
+      models: req.models,
+      language: req.language,
+    },
+    { timeout: 180000 }
+  )
+  return data
+}
+
+/** 多股对比：2～5 只股票横向比较并排名 */
+export async function comparePrediction(req: CompareRequest): Promise<CompareResponse> {
+  const { data } = await client.post<CompareResponse>(
+    '/api/prediction/compare',
+    {
+      codes: req.codes.map(normalizeCode),
+      days: req.days,
+      model: req.model,
+      language: req.language,
+    },
     { timeout: 180000 }
   )
   return data
 }
 
-/** 流式预测：通过 SSE 逐段接收分析内容。onChunk(event, 片段)，event 为 'reasoning'（思考过程）或 'content'（最终输出）。 */
+/** 辩论模式角色事件：bull_content、judge_reasoning 等 */
+const ROLE_EVENT = /^(bull|bear|judge)_(reasoning|content)$/
+
+/** 流式预测：通过 SSE 逐段接收分析内容。onChunk(event, 片段)，event 为 'reasoning'（思考过程）或 'content'（最终输出）；辩论模式下各角色输出经 onRoleChunk 回调；多模型对比时各模型输出经 onModelChunk、完成时经 onModelDone 回调；onContext 收到模型所见的输入数据快照（最先发送）；onResult 收到后处理完成的结构化结果。 */
 export function getPredictionStream(
   req: PredictionRequest,
   callbacks: {
     onChunk: (event: 'reasoning' | 'content', text: string) => void
+    onRoleChunk?: (role: DebateRole, event: 'reasoning' | 'content', text: string) => void
+    onModelChunk?: (model: string, event: 'reasoning' | 'content', text: string) => void
+    onModelDone?: (member: EnsembleMember) => void
+    onWarnings?: (warnings: VerificationWarning[]) => void
+    onResult?: (result: PredictionResponse) => void
+    onQueue?: (status: LLMWaitStatus) => void
+    onBands?: (bands: PriceBands) => void
+    onContext?: (snapshot: DataSnapshot) => void
     onDone: () => void
     onError: (message: string) => void
   }
@@ -66,6 +189,10 @@ export function getPredictionStream(
       days: req.days,
       include_news: req.include_news,
       model: req.model,
+      mode: req.mode,
+      force_refresh: req.force_refresh,
+      models: req.models,
+      language: req.language,
     }),
     signal: abort.signal,
   })
@@ -103,6 +230,56 @@ export function getPredictionStream(
               } catch {
                 callbacks.onChunk(event as 'reasoning' | 'content', data)
               }
+            } else if (ROLE_EVENT.test(event)) {
+              const [role, kind] = event.split('_') as [DebateRole, 'reasoning' | 'content']
+              try {
+                callbacks.onRoleChunk?.(role, kind, JSON.parse(data) as string)
+              } catch {
+                callbacks.onRoleChunk?.(role, kind, data)
+              }
+            } else if (event === 'model_reasoning' || event === 'model_content') {
+              try {
+                const chunk = JSON.parse(data) as { model: string; text: string }
+                callbacks.onModelChunk?.(chunk.model, event === 'model_reasoning' ? 'reasoning' : 'content', chunk.text)
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'model_done') {
+              try {
+                callbacks.onModelDone?.(JSON.parse(data) as EnsembleMember)
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'warnings') {
+              try {
+                callbacks.onWarnings?.(JSON.parse(data) as VerificationWarning[])
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'queue') {
+              try {
+                callbacks.onQueue?.(JSON.parse(data) as LLMWaitStatus)
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'bands') {
+              try {
+                callbacks.onBands?.(JSON.parse(data) as PriceBands)
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'context') {
+              try {
+                callbacks.onContext?.(JSON.parse(data) as DataSnapshot)
+              } catch {
+                // 忽略
+              }
+            } else if (event === 'result') {
+              try {
+                callbacks.onResult?.(JSON.parse(data) as PredictionResponse)
+              } catch {
+                // 忽略无法解析的结果事件，仍以流式内容为准
+              }
             } else if (event === 'error') {
               try {
                 callbacks.onError(JSON.parse(data) as string)
@@ -143,3 +320,78 @@ export function getPredictionStream(
     })
   return () => abort.abort()
 }
+
+/** 自选股批量预测（SSE）：每只股票完成时 onItem，全部完成后 onDigest 收到汇总排序与 Markdown 文档。 */
+export function getBatchPredictionStream(
+  req: BatchPredictionRequest,
+  callbacks: {
+    onItem: (item: BatchItem) => void
+    onDigest: (digest: Digest) => void
+    onDone: () => void
+    onError: (message: string) => void
+  }
+): () => void {
+  const abort = new AbortController()
+  const baseURL = client.defaults.baseURL || ''
+  fetch(`${baseURL}/api/prediction/batch`, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify({ ...req, codes: req.codes.map(normalizeCode) }),
+    signal: abort.signal,
+  })
+    .then(async (res) => {
+      if (!res.ok) {
+        const text = await res.text()
+        callbacks.onError(text || `请求失败 ${res.status}`)
+        return
+      }
+      const reader = res.body?.getReader()
+      if (!reader) {
+        callbacks.onError('不支持流式响应')
+        return
+      }
+      const dec = new TextDecoder()
+      let buffer = ''
+      try {
+        while (true) {
+          const { done, value } = await reader.read()
+          if (done) break
+          buffer += dec.decode(value, { stream: true })
+          const parts = buffer.split('\n\n')
+          buffer = parts.pop() ?? ''
+          for (const part of parts) {
+            let event = ''
+            let data = ''
+            for (const line of part.split('\n')) {
+              if (line.startsWith('event: ')) event = line.slice(7).trim()
+              else if (line.startsWith('data: ')) data = line.slice(6)
+            }
+            try {
+              if (event === 'item') callbacks.onItem(JSON.parse(data) as BatchItem)
+              else if (event === 'digest') callbacks.onDigest(JSON.parse(data) as Digest)
+              else if (event === 'error') {
+                callbacks.onError(JSON.parse(data) as string)
+                return
+              } else if (event === 'done') {
+                callbacks.onDone()
+                return
+              }
+            } catch {
+              if (event === 'error') {
+                callbacks.onError(data)
+                return
+              }
+            }
+          }
+        }
+        callbacks.onDone()
+      } finally {
+        reader.releaseLock()
+      }
+    })
+    .catch((e: unknown) => {
+      if (e instanceof Error && e.name === 'AbortError') return
+      callbacks.onError(e instanceof Error ? e.message : String(e))
+    })
+  return () => abort.abort()
+}
@@ -0,0 +1,69 @@
+import type { PriceBands } from '../types'
+
+const WIDTH = 560
+const HEIGHT = 220
+const PAD = { top: 12, right: 64, bottom: 28, left: 56 }
+
+/** 蒙特卡洛价格扇形图：5%～95% 与 25%～75% 分位带及中位数，第 0 日为起始价 */
+export default function FanChart({ bands }: { bands: PriceBands }) {
+  const points = [
+    { day: 0, p5: bands.price, p25: bands.price, p50: bands.price, p75: bands.price, p95: bands.price },
+    ...bands.points,
+  ]
+  const lo = Math.min(...points.map((p) => p.p5))
+  const hi = Math.max(...points.map((p) => p.p95))
+  const span = hi - lo || 1
+  const innerW = WIDTH - PAD.left - PAD.right
+  const innerH = HEIGHT - PAD.top - PAD.bottom
+  const x = (day: number) => PAD.left + (day / bands.days) * innerW
+  const y = (v: number) => PAD.top + (1 - (v - lo) / span) * innerH
+  const area = (upper: 'p95' | 'p75', lower: 'p5' | 'p25') =>
+    [
+      ...points.map((p) => `${x(p.day)},${y(p[upper])}`),
+      ...[...points].reverse().map((p) => `${x(p.day)},${y(p[lower])}`),
+    ].join(' ')
+  const median = points.map((p) => `${x(p.day)},${y(p.p50)}`).join(' ')
+  const last = points[points.length - 1]
+  const pct = (v: number) => `${v >= bands.price ? '+' : ''}${((v / bands.price - 1) * 100).toFixed(1)}%`
+  const ticks = [lo, (lo + hi) / 2, hi]
+
+  return (
+    <div className="fan-chart">
+      <div className="fan-chart-title">
+        价格分布（{bands.paths} 次{bands.model === 'bootstrap' ? '历史收益抽样' : '几何布朗运动'}模拟，日波动率{' '}
+        {bands.daily_vol_pct.toFixed(2)}%）
+      </div>
+      <svg viewBox={`0 0 ${WIDTH} ${HEIGHT}`} className="fan-chart-svg">
+        {ticks.map((t) => (
+          <g key={t}>
+            <line x1={PAD.left} x2={WIDTH - PAD.right} y1={y(t)} y2={y(t)} className="fan-chart-grid" />
+            <text x={PAD.left - 6} y={y(t) + 4} textAnchor="end" className="fan-chart-label">
+              {t.toFixed(2)}
+            </text>
+          </g>
+        ))}
+        <polygon points={area('p95', 'p5')} className="fan-chart-outer" />
+        <polygon points={area('p75', 'p25')} className="fan-chart-inner" />
+        <polyline points={median} className="fan-chart-median" />
+        {points.map((p) => (
+          <text key={p.day} x={x(p.day)} y={HEIGHT - 8} textAnchor="middle" className="fan-chart-label">
+            {p.day === 0 ? '现价' : `T+${p.day}`}
+          </text>
+        ))}
+        {(['p95', 'p75', 'p50', 'p25', 'p5'] as const).map((k) => (
+          <text key={k} x={WIDTH - PAD.right + 6} y={y(last[k]) + 4} className="fan-chart-label">
+            {pct(last[k])}
+          </text>
+        ))}
+      </svg>
+      <div className="fan-chart-legend">
+        <span className="fan-chart-swatch outer" />
+        5%～95%
+        <span className="fan-chart-swatch inner" />
+        25%～75%
+        <span className="fan-chart-swatch median" />
+        中位数
+      </div>
+    </div>
+  )
+}
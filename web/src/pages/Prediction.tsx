@@ -1,29 +1,97 @@
 import { useCallback, useEffect, useRef, useState } from 'react'
 import { useSearchParams } from 'react-router-dom'
 import ReactMarkdown from 'react-markdown'
-import { getPredictionStream } from '../api/stock'
-import type { PredictionRequest } from '../types'
+import { comparePrediction, getBatchPredictionStream, getPredictionStream } from '../api/stock'
+import FanChart from '../components/FanChart'
+import type {
+  CompareResponse,
+  DataSnapshot,
+  EnsembleMember,
+  LLMWaitStatus,
+  OutputLanguage,
+  PredictionRequest,
+  PriceBands,
+  VerificationWarning,
+} from '../types'
+
+function waitText(s: LLMWaitStatus): string {
+  const sec = Math.ceil((s.delay_ms ?? 0) / 1000)
+  if (s.reason === 'queue') return `排队中，第 ${s.position} 位`
+  if (s.reason === 'rate') return `请求较多，约 ${sec} 秒后开始`
+  return `模型服务繁忙，${sec} 秒后第 ${s.attempt} 次重试`
+}
+
+/** 与首页自选股共用的 localStorage key */
+const WATCHLIST_KEY = 'hk_watchlist'
+
+function loadWatchlist(): string[] {
+  try {
+    const s = localStorage.getItem(WATCHLIST_KEY)
+    if (s) return [...new Set(JSON.parse(s) as string[])]
+  } catch (_) {}
+  return []
+}
 
 const MODEL_OPTIONS = [
   { value: 'GLM-4.7-Flash', label: 'GLM-4.7-Flash' },
   { value: 'glm-5', label: 'GLM-5' },
+  { value: 'quant', label: '规则模型（无需 LLM）' },
 ] as const
 
+const LANGUAGE_OPTIONS: { value: OutputLanguage; label: string }[] = [
+  { value: 'zh-CN', label: '简体中文' },
+  { value: 'zh-TW', label: '繁體中文' },
+  { value: 'en', label: 'English' },
+]
+
+const DIRECTION_LABEL: Record<string, string> = { bullish: '看多', bearish: '看空', neutral: '震荡' }
+
+/** 多模型对比时单个模型的输出 */
+interface ModelPanel {
+  text: string
+  member?: EnsembleMember
+}
+
+const SOURCE_LABELS: Record<string, string> = { quote: '个股行情', market: '大盘指数', kline: '日 K' }
+
+function memberSummary(m: EnsembleMember): string {
+  if (m.error) return `失败：${m.error}`
+  const v = m.result?.verdict
+  const weight = `权重 ${m.weight.toFixed(2)}`
+  if (!v) return `未给出结论 · ${weight}`
+  return `${DIRECTION_LABEL[v.direction] ?? v.direction} · 置信度 ${v.confidence.toFixed(2)} · ${weight}`
+}
+
 export default function Prediction() {
   const [searchParams] = useSearchParams()
   const codeFromQuery = searchParams.get('code') ?? ''
   const [code, setCode] = useState(codeFromQuery || 'hk02513')
   const [days, setDays] = useState(3)
   const [model, setModel] = useState<string>(MODEL_OPTIONS[0].value)
+  const [language, setLanguage] = useState<OutputLanguage>('zh-CN')
   const [streamingText, setStreamingText] = useState('')
   const [finalOutput, setFinalOutput] = useState('')
   const [fullStreamedText, setFullStreamedText] = useState('')
   const [resultTab, setResultTab] = useState<'summary' | 'stream'>('summary')
   const [loading, setLoading] = useState(false)
+  const [waiting, setWaiting] = useState('')
   const [error, setError] = useState('')
   const contentRef = useRef('')
   const fullTextRef = useRef('')
   const streamContainerRef = useRef<HTMLDivElement>(null)
+  const [batchLoading, setBatchLoading] = useState(false)
+  const [batchProgress, setBatchProgress] = useState({ done: 0, total: 0 })
+  const [digest, setDigest] = useState('')
+  const [bands, setBands] = useState<PriceBands | null>(null)
+  // 勾选 2 个及以上模型时对比：同一数据快照并发预测，按历史命中率加权投票
+  const [compare, setCompare] = useState<string[]>([])
+  const [panels, setPanels] = useState<Record<string, ModelPanel>>({})
+  const [warnings, setWarnings] = useState<VerificationWarning[]>([])
+  // 模型所见的输入数据快照（context 事件最先到达，关键数据缺失而拒绝预测时也会发送）
+  const [snapshot, setSnapshot] = useState<DataSnapshot | null>(null)
+  // 多股对比：股票代码框中以逗号或空格分隔 2～5 只
+  const [stockCompare, setStockCompare] = useState<CompareResponse | null>(null)
+  const [stockCompareLoading, setStockCompareLoading] = useState(false)
 
   useEffect(() => {
     if (codeFromQuery) setCode(codeFromQuery)
@@ -42,34 +110,74 @@ export default function Prediction() {
     setStreamingText('')
     contentRef.current = ''
     fullTextRef.current = ''
+    setWaiting('')
+    setBands(null)
+    setPanels({})
+    setWarnings([])
+    setSnapshot(null)
     setLoading(true)
     const req: PredictionRequest = {
       code,
       days,
       include_news: true,
       model,
+      models: compare.length >= 2 ? compare : undefined,
+      language,
     }
     const cancel = getPredictionStream(req, {
       onChunk(event, t) {
+        setWaiting('')
         fullTextRef.current += t
         setStreamingText(fullTextRef.current)
         if (event === 'content') {
           contentRef.current += t
         }
       },
+      onModelChunk(m, event, t) {
+        setWaiting('')
+        if (event !== 'content') return
+        setPanels((prev) => ({ ...prev, [m]: { ...prev[m], text: (prev[m]?.text ?? '') + t } }))
+      },
+      onModelDone(member) {
+        setPanels((prev) => ({ ...prev, [member.model]: { text: prev[member.model]?.text ?? '', member } }))
+      },
+      onWarnings(ws) {
+        setWarnings(ws)
+      },
+      onQueue(status) {
+        setWaiting(waitText(status))
+      },
+      onBands(b) {
+        setBands(b)
+      },
+      onContext(snap) {
+        setSnapshot(snap)
+      },
+      onResult(result) {
+        // 结果中的 analysis 已去掉末尾的结构化 JSON 代码块
+        contentRef.current = result.analysis
+        if (result.bands) setBands(result.bands)
+        if (result.context) setSnapshot(result.context)
+      },
       onDone() {
         setLoading(false)
+        setWaiting('')
         setFinalOutput(contentRef.current)
         setFullStreamedText(fullTextRef.current)
         setStreamingText('')
       },
       onError(msg) {
         setLoading(false)
+        setWaiting('')
         setError(msg)
       },
     })
     return cancel
-  }, [code, days, model])
+  }, [code, days, model, compare, language])
+
+  const toggleCompare = (value: string) => {
+    setCompare((prev) => (prev.includes(value) ? prev.filter((v) => v !== value) : [...prev, value]))
+  }
 
   const cancelRef = useRef<(() => void) | null>(null)
   useEffect(() => {
@@ -83,6 +191,57 @@ export default function Prediction() {
     cancelRef.current = runStream()
   }
 
+  // 自选股批量预测：逐只完成时更新进度，最后展示汇总排序文档
+  const handleBatch = () => {
+    const codes = loadWatchlist()
+    if (codes.length === 0) {
+      setError('自选股为空，请先在首页添加')
+      return
+    }
+    cancelRef.current?.()
+    setError('')
+    setDigest('')
+    setBatchProgress({ done: 0, total: codes.length })
+    setBatchLoading(true)
+    cancelRef.current = getBatchPredictionStream(
+      { codes, days, model, language },
+      {
+        onItem(item) {
+          setBatchProgress((p) => ({ done: p.done + 1, total: item.total }))
+        },
+        onDigest(d) {
+          setDigest(d.document)
+        },
+        onDone() {
+          setBatchLoading(false)
+        },
+        onError(msg) {
+          setBatchLoading(false)
+          setError(msg)
+        },
+      }
+    )
+  }
+
+  const handleStockCompare = async () => {
+    const codes = code.split(/[\s,，、]+/).filter(Boolean)
+    if (codes.length < 2) {
+      setError('多股对比请在股票代码中输入 2～5 只，以逗号或空格分隔')
+      return
+    }
+    setError('')
+    setStockCompare(null)
+    setStockCompareLoading(true)
+    try {
+      setStockCompare(await comparePrediction({ codes, days, model, language }))
+    } catch (e) {
+      const err = e as { response?: { data?: unknown }; message?: string }
+      setError(typeof err.response?.data === 'string' ? err.response.data : err.message || String(e))
+    } finally {
+      setStockCompareLoading(false)
+    }
+  }
+
   return (
     <div className="page">
       <header className="header">
@@ -96,7 +255,7 @@ export default function Prediction() {
               type="text"
               value={code}
               onChange={(e) => setCode(e.target.value)}
-              placeholder="hk02513"
+              placeholder="hk02513；多股对比：hk00700,hk09988"
               className="prediction-input"
             />
           </label>
@@ -112,7 +271,12 @@ export default function Prediction() {
           </label>
           <label className="prediction-field">
             <span className="prediction-field-label">模型</span>
-            <select value={model} onChange={(e) => setModel(e.target.value)} className="prediction-select">
+            <select
+              value={model}
+              onChange={(e) => setModel(e.target.value)}
+              className="prediction-select"
+              disabled={compare.length >= 2}
+            >
               {MODEL_OPTIONS.map((opt) => (
                 <option key={opt.value} value={opt.value}>
                   {opt.label}
@@ -120,9 +284,49 @@ export default function Prediction() {
               ))}
             </select>
           </label>
+          <label className="prediction-field">
+            <span className="prediction-field-label">输出语言</span>
+            <select
+              value={language}
+              onChange={(e) => setLanguage(e.target.value as OutputLanguage)}
+              className="prediction-select"
+            >
+              {LANGUAGE_OPTIONS.map((opt) => (
+                <option key={opt.value} value={opt.value}>
+                  {opt.label}
+                </option>
+              ))}
+            </select>
+          </label>
+          <div className="prediction-field">
+            <span className="prediction-field-label">对比模型</span>
+            <div className="prediction-compare">
+              {MODEL_OPTIONS.map((opt) => (
+                <label key={opt.value} className="prediction-compare-option">
+                  <input
+                    type="checkbox"
+                    checked={compare.includes(opt.value)}
+                    onChange={() => toggleCompare(opt.value)}
+                  />
+                  {opt.label}
+                </label>
+              ))}
+            </div>
+          </div>
         </div>
         <button type="button" onClick={handleStart} disabled={loading} className="btn primary prediction-btn">
-          {loading ? '生成中…' : '开始预测'}
+          {loading ? waiting || '生成中…' : '开始预测'}
+        </button>
+        <button type="button" onClick={handleBatch} disabled={loading || batchLoading} className="btn prediction-btn">
+          {batchLoading ? `自选股预测中 ${batchProgress.done}/${batchProgress.total}` : '预测全部自选股'}
+        </button>
+        <button
+          type="button"
+          onClick={handleStockCompare}
+          disabled={loading || stockCompareLoading}
+          className="btn prediction-btn"
+        >
+          {stockCompareLoading ? '对比分析中…' : '多股对比'}
         </button>
       </div>
       {error && (
@@ -130,6 +334,123 @@ export default function Prediction() {
           {error}
         </div>
       )}
+      {stockCompare && (
+        <div className="card prediction-result-card">
+          <ul className="sector-list">
+            {stockCompare.stocks.map((st) => (
+              <li key={st.code} className="sector-row">
+                <span className="sector-name">
+                  {st.rank > 0 ? `#${st.rank} ` : '— '}
+                  {st.name || st.code}
+                  <span className="sector-code">{st.code}</span>
+                </span>
+                <span className="sector-value">
+                  {st.verdict
+                    ? `${st.verdict.direction} ${Math.round(st.verdict.confidence * 100)}% ` +
+                      `(${st.verdict.change_low_pct}% ~ ${st.verdict.change_high_pct}%)`
+                    : '未给出结论'}
+                </span>
+              </li>
+            ))}
+          </ul>
+          {stockCompare.stocks.some((st) => st.reason) && (
+            <ul>
+              {stockCompare.stocks
+                .filter((st) => st.reason)
+                .map((st) => (
+                  <li key={st.code}>
+                    <strong>{st.name || st.code}：</strong>
+                    {st.reason}
+                  </li>
+                ))}
+            </ul>
+          )}
+          {stockCompare.warnings && stockCompare.warnings.length > 0 && (
+            <p className="muted" style={{ fontSize: 12 }}>
+              数字核对提示：{stockCompare.warnings.map((w) => w.message).join('；')}
+            </p>
+          )}
+          <div className="markdown-content">
+            <ReactMarkdown>{stockCompare.analysis || '—'}</ReactMarkdown>
+          </div>
+        </div>
+      )}
+      {digest && (
+        <div className="card prediction-result-card">
+          <div className="markdown-content">
+            <ReactMarkdown>{digest}</ReactMarkdown>
+          </div>
+        </div>
+      )}
+      {snapshot && (
+        <div className="card">
+          <div className="prediction-warnings-title">
+            模型所见数据（{new Date(snapshot.time).toLocaleString()}，{snapshot.is_trading ? '交易时段' : '非交易时段'}）
+          </div>
+          <ul>
+            {snapshot.quote && (
+              <li>
+                {snapshot.quote.name} {snapshot.quote.code}：现价 {snapshot.quote.current_price}，涨跌幅{' '}
+                {snapshot.quote.change_percent.toFixed(2)}%，成交量 {snapshot.quote.volume}
+                {snapshot.quote.timestamp && `（行情时间 ${snapshot.quote.timestamp}）`}
+              </li>
+            )}
+            {snapshot.indices?.map((idx) => (
+              <li key={idx.name}>
+                {idx.name}：{idx.value.toFixed(2)}（{idx.change_percent.toFixed(2)}%）
+              </li>
+            ))}
+            {snapshot.bars > 0 && (
+              <li>
+                日 K {snapshot.bars} 根，截至 {snapshot.last_bar_date}
+              </li>
+            )}
+          </ul>
+          <div className="muted" style={{ fontSize: 12 }}>
+            {snapshot.sources.map((src) => (
+              <div key={src.name} style={src.ok ? undefined : { color: '#c62828' }}>
+                {SOURCE_LABELS[src.name] ?? src.name}：{src.ok ? `成功（${src.elapsed_ms} ms）` : `获取失败 ${src.error ?? ''}`}
+                {!src.ok && (src.critical ? '，已停止预测' : '，分析未参考该项数据')}
+              </div>
+            ))}
+          </div>
+        </div>
+      )}
+      {warnings.some((w) => w.kind !== 'data') && (
+        <div className="card prediction-warnings">
+          <div className="prediction-warnings-title">数字核对提示（回答中的以下内容与行情数据不符或不合理，请谨慎参考）</div>
+          <ul>
+            {warnings.filter((w) => w.kind !== 'data').map((w, i) => (
+              <li key={i}>
+                {w.model && <strong>{w.model}：</strong>}
+                {w.message}
+              </li>
+            ))}
+          </ul>
+        </div>
+      )}
+      {bands && bands.points.length > 0 && (
+        <div className="card">
+          <FanChart bands={bands} />
+        </div>
+      )}
+      {Object.keys(panels).length > 0 && (
+        <div className="card">
+          <div className="model-panels">
+            {Object.entries(panels).map(([m, panel]) => (
+              <div key={m} className="model-panel">
+                <div className="model-panel-header">
+                  <strong>{m}</strong>
+                  <span className="model-panel-status">{panel.member ? memberSummary(panel.member) : '生成中…'}</span>
+                </div>
+                <div className="markdown-stream model-panel-body">
+                  <ReactMarkdown>{panel.text || '—'}</ReactMarkdown>
+                </div>
+              </div>
+            ))}
+          </div>
+        </div>
+      )}
       {((loading && streamingText) || (!loading && (finalOutput || fullStreamedText))) && (
         <div className="card prediction-result-card">
           <div className="prediction-result-tabs">
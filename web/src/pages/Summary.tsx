@@ -1,6 +1,7 @@
 import { useEffect, useState } from 'react'
-import { getMarketSummary, getSectors } from '../api/stock'
-import type { MarketIndexItem, SectorItem, SectorsResponse } from '../types'
+import ReactMarkdown from 'react-markdown'
+import { getMarketCommentaryStream, getMarketSummary, getSectors } from '../api/stock'
+import type { MarketCommentary, MarketIndexItem, MarketOverview, MarketPhase, SectorItem, SectorsResponse } from '../types'
 
 function getColor(change: number) {
   if (change > 0) return '#F44336'
@@ -18,12 +19,25 @@ function formatFlow(v: number): string {
 
 type SectorTab = 'change' | 'capital'
 
+const PHASE_LABELS: Record<MarketPhase, string> = {
+  pre_open: '开盘前',
+  morning: '早市',
+  lunch: '午间休市',
+  afternoon: '午市',
+  closed: '收市后',
+}
+
 export default function Summary() {
   const [indices, setIndices] = useState<MarketIndexItem[]>([])
   const [sectors, setSectors] = useState<SectorsResponse | null>(null)
   const [loading, setLoading] = useState(true)
   const [sectorsLoading, setSectorsLoading] = useState(true)
   const [sectorTab, setSectorTab] = useState<SectorTab>('change')
+  const [overview, setOverview] = useState<MarketOverview | null>(null)
+  const [commentaryText, setCommentaryText] = useState('')
+  const [commentary, setCommentary] = useState<MarketCommentary | null>(null)
+  const [commentaryError, setCommentaryError] = useState('')
+  const [commentaryLoading, setCommentaryLoading] = useState(true)
 
   useEffect(() => {
     getMarketSummary()
@@ -38,6 +52,28 @@ export default function Summary() {
     return () => window.clearInterval(timer)
   }, [])
 
+  // AI 点评同一时段只生成一次，进入页面时取一次即可（已生成时为重放）
+  useEffect(() => {
+    return getMarketCommentaryStream(
+      {},
+      {
+        onOverview: setOverview,
+        onChunk: (event, text) => {
+          if (event === 'content') setCommentaryText((prev) => prev + text)
+        },
+        onCommentary: (c) => {
+          setCommentary(c)
+          if (c.overview) setOverview(c.overview)
+        },
+        onDone: () => setCommentaryLoading(false),
+        onError: (msg) => {
+          setCommentaryError(msg)
+          setCommentaryLoading(false)
+        },
+      }
+    )
+  }, [])
+
   useEffect(() => {
     getSectors()
       .then(setSectors)
@@ -69,6 +105,50 @@ export default function Summary() {
         ))}
       </div>
 
+      <section className="sector-section">
+        <h2 className="section-title">
+          AI 大市点评
+          {commentary && (
+            <span className="muted" style={{ fontSize: 12, fontWeight: 'normal', marginLeft: 8 }}>
+              {commentary.session_date} {PHASE_LABELS[commentary.phase] ?? commentary.phase} · {commentary.model} ·{' '}
+              {new Date(commentary.generated_at).toLocaleTimeString('zh-CN', { hour12: false })} 生成
+            </span>
+          )}
+        </h2>
+        <div className="card">
+          {overview?.breadth && (
+            <p className="muted" style={{ marginTop: 0 }}>
+              上涨 <span style={{ color: getColor(1) }}>{overview.breadth.advancers}</span> 家 · 下跌{' '}
+              <span style={{ color: getColor(-1) }}>{overview.breadth.decliners}</span> 家 · 平盘 {overview.breadth.unchanged} 家
+              {(overview.southbound || []).length > 0 && (
+                <>
+                  {' '}· 南向净买入{' '}
+                  {(() => {
+                    const net = (overview.southbound || []).reduce((sum, s) => sum + s.net_buy, 0)
+                    return (
+                      <span style={{ color: getColor(net) }}>
+                        {net >= 0 ? '+' : ''}{net.toFixed(2)} 亿元
+                      </span>
+                    )
+                  })()}
+                </>
+              )}
+            </p>
+          )}
+          {commentaryError && <p className="muted">AI 点评暂不可用：{commentaryError}</p>}
+          {!commentaryError && commentaryLoading && !commentaryText && <p className="muted">AI 点评生成中…</p>}
+          {(commentary?.commentary || commentaryText) && (
+            <div className="markdown-content">
+              <ReactMarkdown>{commentary?.commentary || commentaryText}</ReactMarkdown>
+            </div>
+          )}
+          {(commentary?.overview?.warnings || []).length > 0 && (
+            <p className="muted" style={{ fontSize: 12 }}>部分数据获取失败，点评未涵盖：{commentary?.overview?.warnings?.join('；')}</p>
+          )}
+          <p className="muted" style={{ fontSize: 12, marginBottom: 0 }}>AI 生成，仅供参考，不构成投资建议。</p>
+        </div>
+      </section>
+
       <section className="sector-section">
         <h2 className="section-title">港股涨跌与资金流向</h2>
         {sectorsLoading && <p className="muted">港股数据加载中…</p>}
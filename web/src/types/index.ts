@@ -18,11 +18,154 @@ export interface MarketSummaryResponse {
   indices: MarketIndexItem[]
 }
 
+export interface TechnicalIndicators {
+  as_of: string
+  bars: number
+  close: number
+  ma5: number
+  ma10: number
+  ma20: number
+  ma60: number
+  macd_dif: number
+  macd_dea: number
+  macd_hist: number
+  rsi6: number
+  rsi12: number
+  rsi24: number
+  kdj_k: number
+  kdj_d: number
+  kdj_j: number
+  boll_upper: number
+  boll_mid: number
+  boll_lower: number
+  volume_ratio: number
+  high20: number
+  low20: number
+}
+
+export interface Verdict {
+  direction: 'bullish' | 'bearish' | 'neutral'
+  confidence: number
+  change_low_pct: number
+  change_high_pct: number
+  price_low: number
+  price_high: number
+}
+
+export interface ToolInvocation {
+  step: number
+  name: string
+  arguments: string
+  result?: string
+  error?: string
+  elapsed_ms: number
+}
+
+export interface Debate {
+  bull: string
+  bear: string
+}
+
+export type DebateRole = 'bull' | 'bear' | 'judge'
+
+/** 蒙特卡洛模拟第 day 个交易日收盘价分位数 */
+export interface PriceBandPoint {
+  day: number
+  p5: number
+  p25: number
+  p50: number
+  p75: number
+  p95: number
+}
+
+/** 蒙特卡洛价格分位带（SSE bands 事件与预测结果中的 bands） */
+export interface PriceBands {
+  model: 'gbm' | 'bootstrap'
+  days: number
+  paths: number
+  lookback: number
+  price: number
+  daily_vol_pct: number
+  drift_pct: number
+  points: PriceBandPoint[]
+}
+
 export interface PredictionResponse {
   code: string
+  model?: string
   confidence: number
   analysis: string
   news_summary: string
+  verdict?: Verdict | null
+  technical?: TechnicalIndicators | null
+  template_version?: string
+  prediction_id?: string
+  tool_calls?: ToolInvocation[] | null
+  mode?: 'single' | 'debate'
+  debate?: Debate | null
+  /** 来自结果缓存或与进行中的相同请求合并 */
+  cached?: boolean
+  /** 模型给出的原始置信度（0 表示未给出）；calibrated 为 true 时 confidence 为按历史命中率校准后的值 */
+  raw_confidence?: number
+  calibrated?: boolean
+  bands?: PriceBands | null
+  /** 多模型对比（请求含 models）时各模型结果与加权投票，此时 model 为 ensemble */
+  ensemble?: Ensemble | null
+  /** 数字核对告警：回答引用的现价、涨跌幅、恒指点位、成交量与数据不符，或结论区间不合理 */
+  warnings?: VerificationWarning[] | null
+  language?: OutputLanguage
+  /** 模型所见的输入数据快照（流式时另以 context 事件最先发送） */
+  context?: DataSnapshot | null
+}
+
+/** 一项输入数据的获取情况：quote（个股行情，关键数据，失败时拒绝预测）、market（大盘指数）、kline（日 K） */
+export interface DataSource {
+  name: 'quote' | 'market' | 'kline'
+  ok: boolean
+  critical?: boolean
+  error?: string
+  fetched_at: string
+  elapsed_ms: number
+}
+
+/** 预测输入数据快照 */
+export interface DataSnapshot {
+  code: string
+  time: string
+  is_trading: boolean
+  quote?: RealtimeResponse | null
+  indices?: MarketIndexItem[] | null
+  bars: number
+  last_bar_date?: string
+  sources: DataSource[]
+}
+
+export interface VerificationWarning {
+  /** data 为非关键输入数据获取失败，其余为数字核对 */
+  kind: 'price' | 'change_pct' | 'index' | 'volume' | 'unsupported_price' | 'range' | 'data'
+  message: string
+  cited?: number
+  expected?: number
+  /** 多模型对比时产生告警的模型 */
+  model?: string
+}
+
+/** 多模型对比中的一个模型：weight 为投票权重（历史方向命中率，无历史时取其他模型平均值） */
+export interface EnsembleMember {
+  model: string
+  weight: number
+  accuracy: number
+  samples: number
+  result?: PredictionResponse
+  error?: string
+}
+
+export interface Ensemble {
+  members: EnsembleMember[]
+  /** 方向 → 权重之和 */
+  votes: Record<string, number>
+  /** 胜出方向的权重占比 */
+  agreement: number
 }
 
 export interface PredictionRequest {
@@ -30,6 +173,123 @@ export interface PredictionRequest {
   days: number
   include_news: boolean
   model: string
+  /** debate：多方、空方分析师与裁判三次调用 */
+  mode?: 'single' | 'debate'
+  /** 跳过结果缓存重新调用模型 */
+  force_refresh?: boolean
+  /** 同一数据快照对比多个模型（2～5 个）并加权投票，此时忽略 model */
+  models?: string[]
+  /** 输出语言，为空时网关按浏览器 Accept-Language 选择 */
+  language?: OutputLanguage
+}
+
+/** 预测输出语言：简体中文、繁体中文（港台用语）、英文 */
+export type OutputLanguage = 'zh-CN' | 'zh-TW' | 'en'
+
+/** LLM 调用等待状态（SSE queue 事件）：排队、限速或 429/5xx 后退避重试 */
+export interface LLMWaitStatus {
+  reason: 'queue' | 'rate' | 'retry'
+  position?: number
+  attempt?: number
+  delay_ms?: number
+  error?: string
+}
+
+export interface BatchPredictionRequest {
+  codes: string[]
+  days: number
+  model: string
+  mode?: 'single' | 'debate'
+  force_refresh?: boolean
+  /** 输出语言，单只失败的错误文案同样按该语言 */
+  language?: OutputLanguage
+}
+
+/** 批量预测中单只股票的结果（SSE item 事件） */
+export interface BatchItem {
+  index: number
+  total: number
+  code: string
+  result?: PredictionResponse
+  error?: string
+}
+
+export interface DigestEntry {
+  code: string
+  direction: string
+  confidence: number
+  change_low_pct: number
+  change_high_pct: number
+  strength: number
+  summary: string
+  prediction_id?: string
+}
+
+/** 批量预测汇总（SSE digest 事件），document 为 Markdown 文档 */
+export interface Digest {
+  created_at: string
+  days: number
+  total: number
+  bullish: DigestEntry[]
+  bearish: DigestEntry[]
+  neutral: DigestEntry[]
+  unrated: DigestEntry[]
+  top_confidence: DigestEntry[]
+  risks: { code: string; reason: string }[]
+  failed: { code: string; error: string }[]
+  items: BatchItem[]
+  document: string
+}
+
+export interface CompareRequest {
+  codes: string[]
+  days: number
+  model: string
+  language?: OutputLanguage
+}
+
+/** 多股对比中的一只股票；rank 为 0 表示模型未给出排名 */
+export interface CompareStock {
+  code: string
+  name?: string
+  price: number
+  change_percent: number
+  rank: number
+  verdict?: Verdict | null
+  reason?: string
+  technical?: TechnicalIndicators | null
+  bands?: PriceBands | null
+  prediction_id?: string
+  context?: DataSnapshot | null
+}
+
+/** 多股对比结果，stocks 按排名升序 */
+export interface CompareResponse {
+  days: number
+  model: string
+  template_version: string
+  language: string
+  analysis: string
+  stocks: CompareStock[]
+  warnings?: VerificationWarning[] | null
+}
+
+export type PredictionJobStatus = 'queued' | 'running' | 'succeeded' | 'failed' | 'canceled'
+
+/** 异步预测任务（/api/prediction/jobs） */
+export interface PredictionJob {
+  id: string
+  status: PredictionJobStatus
+  stage: string
+  /** 0～100，按阶段估计 */
+  progress: number
+  code: string
+  created_at: string
+  started_at: string
+  finished_at: string
+  error: string
+  queue_position: number
+  result?: PredictionResponse
 }
 
 export interface SectorItem {
@@ -47,3 +307,49 @@ export interface SectorsResponse {
   by_change: SectorItem[]
   by_capital: SectorItem[]
 }
+
+/** 全市场涨跌幅/主力资金排行中的一只股票（主力净流入单位：港元） */
+export interface MarketMover {
+  code: string
+  name: string
+  price: number
+  change_percent: number
+  main_net_inflow: number
+}
+
+/** 港股通（南向）单个通道，单位：亿元人民币 */
+export interface SouthboundFlow {
+  channel: string
+  net_buy: number
+  quota_remain: number
+}
+
+/** 市场概览：指数、涨跌家数、排行与南向资金；单项获取失败时为空，原因见 warnings */
+export interface MarketOverview {
+  indices?: MarketIndexItem[]
+  breadth?: { advancers: number; decliners: number; unchanged: number }
+  gainers?: MarketMover[]
+  losers?: MarketMover[]
+  inflow_leaders?: MarketMover[]
+  outflow_leaders?: MarketMover[]
+  southbound?: SouthboundFlow[]
+  timestamp: string
+  warnings?: string[]
+}
+
+/** 市场时段（香港时间） */
+export type MarketPhase = 'pre_open' | 'morning' | 'lunch' | 'afternoon' | 'closed'
+
+/** AI 大市点评：同一时段只生成一次 */
+export interface MarketCommentary {
+  session_date: string
+  phase: MarketPhase
+  commentary: string
+  model: string
+  template_version: string
+  language: string
+  overview?: MarketOverview
+  generated_at: string
+  expires_at: string
+  cached?: boolean
+}